@@ -0,0 +1,107 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/attest"
+	"github.com/eu-sovereign-cloud/conformance/internal/report"
+	"github.com/spf13/cobra"
+)
+
+func newAttestCmd() *cobra.Command {
+	var output, signingKey string
+	cmd := &cobra.Command{
+		Use:   "attest <results-path>",
+		Short: "Package the results, summary and configuration of a run into an evidence bundle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var key ed25519.PrivateKey
+			if signingKey != "" {
+				var err error
+				if key, err = attest.ReadPrivateKey(signingKey); err != nil {
+					return err
+				}
+			}
+
+			s, err := report.BuildSummary(args[0])
+			if err != nil {
+				return fmt.Errorf("building summary: %w", err)
+			}
+
+			file, err := os.Create(output)
+			if err != nil {
+				return fmt.Errorf("creating bundle: %w", err)
+			}
+			defer file.Close()
+
+			manifest, err := attest.Create(file, attest.Evidence{ResultsPath: args[0], Summary: s}, key)
+			if err != nil {
+				return fmt.Errorf("writing bundle: %w", err)
+			}
+			slog.Info("Wrote evidence bundle", "path", output, "files", len(manifest.Files), "signed", key != nil)
+			return file.Close()
+		},
+	}
+	cmd.Flags().StringVar(&output, "output", "conformance-attestation.tar.gz", "File the bundle is written to")
+	cmd.Flags().StringVar(&signingKey, "signing.key", "", "PEM file of the ed25519 private key signing the bundle manifest")
+
+	cmd.AddCommand(newVerifyCmd())
+	return cmd
+}
+
+func newVerifyCmd() *cobra.Command {
+	var publicKeyPath string
+	cmd := &cobra.Command{
+		Use:   "verify <bundle>",
+		Short: "Check offline the integrity and the signature of an evidence bundle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var publicKey ed25519.PublicKey
+			if publicKeyPath != "" {
+				var err error
+				if publicKey, err = attest.ReadPublicKey(publicKeyPath); err != nil {
+					return err
+				}
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("opening bundle: %w", err)
+			}
+			defer file.Close()
+
+			// A tampered bundle is a result of the check, not a misuse of the command
+			cmd.SilenceUsage = true
+			verification, err := attest.Verify(file, publicKey)
+			if err != nil {
+				return err
+			}
+
+			manifest := verification.Manifest
+			fmt.Printf("Bundle integrity: OK (%d files)\n", len(manifest.Files))
+			switch {
+			case verification.Trusted:
+				fmt.Println("Signature: OK, signed by the given public key")
+			case verification.Signed:
+				fmt.Println("Signature: OK, against the key of the manifest only; give --public.key to check the signer")
+			default:
+				fmt.Println("Signature: none")
+			}
+			fmt.Printf("Run: %s  Profile: %s  Level: %s\n", orDash(manifest.RunID), orDash(manifest.Profile), orDash(manifest.Level))
+			fmt.Printf("Created: %s  Binary: %s  go-sdk: %s\n", manifest.CreatedAt.Format("2006-01-02T15:04:05Z"), manifest.BinaryVersion, manifest.GoSdkVersion)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&publicKeyPath, "public.key", "", "PEM file of the ed25519 public key the bundle must be signed with")
+	return cmd
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -6,57 +6,46 @@ import (
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites/authorization"
-	"github.com/ozontech/allure-go/pkg/framework/suite"
 )
 
 func TestAuthorizationV1Suites(t *testing.T) {
-	globalTestSuite := suites.CreateGlobalTestSuite(config.Parameters, config.Clients)
+	markParallel(t)
+	runSuites(t, authorizationV1Suites())
+}
 
+func authorizationV1Suites() []conformanceSuite {
 	// Provider LifeCycle Suite
-	providerLifeCycleSuite := authorization.CreateProviderLifeCycleV1TestSuite(globalTestSuite, config.Parameters.ScenariosUsers)
-	if providerLifeCycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, providerLifeCycleSuite)
-	}
+	providerLifeCycleSuite := authorization.CreateProviderLifeCycleV1TestSuite(suites.CreateGlobalTestSuite(config.Parameters, config.Clients), config.Parameters.ScenariosUsers)
 
 	// Provider Queries Suite
-	providerQueriesSuite := authorization.CreateProviderQueriesV1TestSuite(globalTestSuite, config.Parameters.ScenariosUsers)
-	if providerQueriesSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, providerQueriesSuite)
-	}
+	providerQueriesSuite := authorization.CreateProviderQueriesV1TestSuite(suites.CreateGlobalTestSuite(config.Parameters, config.Clients), config.Parameters.ScenariosUsers)
 
 	// Role LifeCycle Suite
-	roleLifeCycleSuite := authorization.CreateRoleLifeCycleV1TestSuite(globalTestSuite)
-	if roleLifeCycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, roleLifeCycleSuite)
-	}
+	roleLifeCycleSuite := authorization.CreateRoleLifeCycleV1TestSuite(suites.CreateGlobalTestSuite(config.Parameters, config.Clients))
 
 	// Role Constraints Violations Suite
-	roleConstraintsViolationsSuite := authorization.CreateRoleConstraintsValidationV1TestSuite(globalTestSuite)
-	if roleConstraintsViolationsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, roleConstraintsViolationsSuite)
-	}
+	roleConstraintsViolationsSuite := authorization.CreateRoleConstraintsValidationV1TestSuite(suites.CreateGlobalTestSuite(config.Parameters, config.Clients))
 
 	// Role Errors Suite
-	roleErrorSuite := authorization.CreateRoleErrorV1TestSuite(globalTestSuite)
-	if roleErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, roleErrorSuite)
-	}
+	roleErrorSuite := authorization.CreateRoleErrorV1TestSuite(suites.CreateGlobalTestSuite(config.Parameters, config.Clients))
 
 	// Role Assignment LifeCycle Suite
-	roleAssignmentLifeCycleSuite := authorization.CreateRoleAssignmentLifeCycleV1TestSuite(globalTestSuite, config.Parameters.ScenariosUsers)
-	if roleAssignmentLifeCycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, roleAssignmentLifeCycleSuite)
-	}
+	roleAssignmentLifeCycleSuite := authorization.CreateRoleAssignmentLifeCycleV1TestSuite(suites.CreateGlobalTestSuite(config.Parameters, config.Clients), config.Parameters.ScenariosUsers)
 
 	// Role Assignment Constraints Violations Suite
-	roleAssignmentConstraintsSuite := authorization.CreateRoleAssignmentConstraintsValidationV1TestSuite(globalTestSuite, config.Parameters.ScenariosUsers)
-	if roleAssignmentConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, roleAssignmentConstraintsSuite)
-	}
+	roleAssignmentConstraintsSuite := authorization.CreateRoleAssignmentConstraintsValidationV1TestSuite(suites.CreateGlobalTestSuite(config.Parameters, config.Clients), config.Parameters.ScenariosUsers)
 
 	// Role Assignment Errors Suite
-	roleAssignmentErrorSuite := authorization.CreateRoleAssignmentErrorV1TestSuite(globalTestSuite, config.Parameters.ScenariosUsers)
-	if roleAssignmentErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, roleAssignmentErrorSuite)
+	roleAssignmentErrorSuite := authorization.CreateRoleAssignmentErrorV1TestSuite(suites.CreateGlobalTestSuite(config.Parameters, config.Clients), config.Parameters.ScenariosUsers)
+
+	return []conformanceSuite{
+		providerLifeCycleSuite,
+		providerQueriesSuite,
+		roleLifeCycleSuite,
+		roleConstraintsViolationsSuite,
+		roleErrorSuite,
+		roleAssignmentLifeCycleSuite,
+		roleAssignmentConstraintsSuite,
+		roleAssignmentErrorSuite,
 	}
 }
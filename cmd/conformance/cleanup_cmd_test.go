@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/cleanup"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/spf13/cobra"
+)
+
+func newCleanupCmd() *cobra.Command {
+	var dryRun bool
+	var olderThan time.Duration
+	cmd := &cobra.Command{
+		Use:   "cleanup",
+		Short: "Delete leftover resources labeled as conformance fixtures",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// A single run is targeted on purpose, its resources are deleted whatever their age
+			if config.Parameters.RunID != "" && !cmd.Flags().Changed("older.than") {
+				olderThan = 0
+			}
+
+			plan, err := cleanup.BuildPlan(cmd.Context(), config.Clients.GlobalClient, config.Clients.RegionalClient,
+				cleanup.Options{
+					Tenant:    config.Parameters.ClientTenant,
+					OlderThan: olderThan,
+					RunID:     config.Parameters.RunID,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("building cleanup plan: %w", err)
+			}
+			if err := cleanup.WritePlan(os.Stdout, plan); err != nil {
+				return fmt.Errorf("writing cleanup plan: %w", err)
+			}
+			if dryRun || len(plan.Candidates) == 0 {
+				return nil
+			}
+
+			observerConfig := secapi.ResourceObserverConfig{
+				Delay:       time.Duration(config.Parameters.BaseDelay) * time.Second,
+				Interval:    time.Duration(config.Parameters.BaseInterval) * time.Second,
+				MaxAttempts: config.Parameters.MaxAttempts,
+			}
+			return cleanup.ExecutePlan(cmd.Context(), os.Stdout, plan, observerConfig)
+		},
+	}
+	cmd.Flags().BoolVar(&dryRun, "dry.run", false, "Print the cleanup plan without deleting anything")
+	cmd.Flags().DurationVar(&olderThan, "older.than", cleanup.DefaultOlderThan, "Only delete resources created at least this long ago, any age when run.id is set")
+	return cmd
+}
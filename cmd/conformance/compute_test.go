@@ -6,45 +6,38 @@ import (
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites/compute"
-	"github.com/ozontech/allure-go/pkg/framework/suite"
 )
 
 func TestComputeV1Suites(t *testing.T) {
-	regionalTestSuite := suites.CreateRegionalTestSuite(config.Parameters, config.Clients)
+	markParallel(t)
+	runSuites(t, computeV1Suites())
+}
 
+func computeV1Suites() []conformanceSuite {
 	// Provider LifeCycle Suite
-	providerLifeCycleSuite := compute.CreateProviderLifeCycleV1TestSuite(regionalTestSuite,
+	providerLifeCycleSuite := compute.CreateProviderLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&compute.ProviderLifeCycleV1Config{
 			AvailableZones: config.Clients.RegionZones,
 			InstanceSkus:   config.Clients.InstanceSkus,
 			StorageSkus:    config.Clients.StorageSkus,
 		},
 	)
-	if providerLifeCycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, providerLifeCycleSuite)
-	}
 
 	// Provider Queries Suite
-	providerQueriesSuite := compute.CreateProviderQueriesV1TestSuite(regionalTestSuite,
+	providerQueriesSuite := compute.CreateProviderQueriesV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&compute.ProviderQueriesV1Config{
 			AvailableZones: config.Clients.RegionZones,
 			InstanceSkus:   config.Clients.InstanceSkus,
 			StorageSkus:    config.Clients.StorageSkus,
 		},
 	)
-	if providerQueriesSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, providerQueriesSuite)
-	}
 
 	// Instance Constraints Violations Suite
-	instanceConstraintsSuite := compute.CreateInstanceConstraintsValidationV1TestSuite(regionalTestSuite, &compute.InstanceContraintsValidationV1Config{
+	instanceConstraintsSuite := compute.CreateInstanceConstraintsValidationV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients), &compute.InstanceContraintsValidationV1Config{
 		AvailableZones: config.Clients.RegionZones,
 		InstanceSkus:   config.Clients.InstanceSkus,
 		StorageSkus:    config.Clients.StorageSkus,
 	})
-	if instanceConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, instanceConstraintsSuite)
-	}
 
 	instanceErrorSuite := compute.CreateInstanceErrorV1TestSuite(
 		suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
@@ -54,7 +47,11 @@ func TestComputeV1Suites(t *testing.T) {
 			StorageSkus:    config.Clients.StorageSkus,
 		},
 	)
-	if instanceErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, instanceErrorSuite)
+
+	return []conformanceSuite{
+		providerLifeCycleSuite,
+		providerQueriesSuite,
+		instanceConstraintsSuite,
+		instanceErrorSuite,
 	}
 }
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/report"
+	"github.com/spf13/cobra"
+)
+
+func newCoverageCmd() *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "coverage <results-path>",
+		Short: "Print the calls and outcomes of every provider operation in the Allure results",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := report.BuildSummary(args[0])
+			if err != nil {
+				return fmt.Errorf("building summary: %w", err)
+			}
+
+			coverage := report.BuildCoverage(s)
+			switch format {
+			case "json":
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(coverage)
+			default:
+				return report.WriteCoverageText(os.Stdout, coverage)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&format, "format", "text", "Output format: text or json")
+	return cmd
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/report"
+	"github.com/spf13/cobra"
+)
+
+func newDiffCmd() *cobra.Command {
+	var format string
+	var options report.DiffOptions
+	cmd := &cobra.Command{
+		Use:   "diff <old-results-path> <new-results-path>",
+		Short: "Compare the Allure results of two runs, failing on new failures",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// New failures are a result of the comparison, not a misuse of the command
+			cmd.SilenceUsage = true
+
+			oldSummary, err := report.BuildSummary(args[0])
+			if err != nil {
+				return fmt.Errorf("building old summary: %w", err)
+			}
+			newSummary, err := report.BuildSummary(args[1])
+			if err != nil {
+				return fmt.Errorf("building new summary: %w", err)
+			}
+
+			diff := report.BuildDiff(oldSummary, newSummary, options)
+			switch format {
+			case "json":
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				err = enc.Encode(diff)
+			default:
+				err = report.WriteDiffText(os.Stdout, diff)
+			}
+			if err != nil {
+				return fmt.Errorf("writing %s diff: %w", format, err)
+			}
+
+			if failures := diff.NewFailures(); failures > 0 {
+				return fmt.Errorf("%d scenarios failing since the old run", failures)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&format, "format", "text", "Output format: text or json")
+	cmd.Flags().Float64Var(&options.ThresholdPercent, "duration.threshold", 50, "Percentage a scenario duration must increase by to be reported as a regression")
+	cmd.Flags().Int64Var(&options.MinIncreaseMs, "duration.min.increase", 1000, "Milliseconds a scenario duration must increase by to be reported as a regression")
+	return cmd
+}
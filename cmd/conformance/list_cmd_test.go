@@ -0,0 +1,40 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
+	"github.com/spf13/cobra"
+)
+
+func newListCmd() *cobra.Command {
+	var format string
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the suites with their metadata and whether they would run",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Without client settings, the suites are built against an empty region
+			if config.Clients == nil {
+				config.Clients = &config.ClientsHolder{}
+			}
+
+			var list []suites.Metadata
+			for _, testSuite := range allV1Suites() {
+				list = append(list, testSuite.Metadata(config.Parameters.ScenariosSelector))
+			}
+
+			switch format {
+			case "json":
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(list)
+			default:
+				return suites.WriteMetadataTable(os.Stdout, list)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&format, "format", "table", "Output format: table or json")
+	return cmd
+}
@@ -2,23 +2,42 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
-	"os/exec"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
 	"testing"
 
+	"github.com/eu-sovereign-cloud/conformance/internal/attest"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/progress"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/selector"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+	"github.com/eu-sovereign-cloud/conformance/internal/logging"
+	"github.com/eu-sovereign-cloud/conformance/internal/report"
+	"github.com/eu-sovereign-cloud/conformance/pkg/builders"
+	"github.com/eu-sovereign-cloud/conformance/pkg/generators"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
 
 	"github.com/spf13/cobra"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/runner"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
 )
 
 func TestMain(m *testing.M) {
-	setupLogger()
-
 	config.InitParameters()
 
+	// The default settings apply until the command flags are loaded
+	slog.SetDefault(slog.New(logging.NewHandler(os.Stdout, slog.LevelInfo, logging.TextFormat)))
+
 	rootCmd := initCommands(m)
 
 	ctx := context.Background()
@@ -28,12 +47,58 @@ func TestMain(m *testing.M) {
 	}
 }
 
-func setupLogger() {
-	// TODO Configure handler type and log level via env variables
-	opts := &slog.HandlerOptions{Level: slog.LevelInfo}
-	logger := slog.New(slog.NewTextHandler(os.Stdout, opts))
+// logFile keeps the logs of the run, when configured
+var logFile *os.File
+
+// setupLogger writes the records to w, and to the log file of the run once opened, carrying the run ID when known
+func setupLogger(w io.Writer) error {
+	level, err := logging.ParseLevel(config.Parameters.LogLevel)
+	if err != nil {
+		return fmt.Errorf("invalid log.level: %w", err)
+	}
+	format, err := logging.ParseFormat(config.Parameters.LogFormat)
+	if err != nil {
+		return fmt.Errorf("invalid log.format: %w", err)
+	}
+
+	if logFile != nil {
+		w = io.MultiWriter(w, logFile)
+	}
+	logger := slog.New(logging.NewHandler(w, level, format))
+	if config.Parameters.RunID != "" {
+		logger = logger.With(logging.RunIDKey, config.Parameters.RunID)
+	}
 
 	slog.SetDefault(logger)
+	return nil
+}
+
+// setupRunLogger shows the run progress above the logs, and keeps them in the log file of the run, if any
+func setupRunLogger() error {
+	if config.Parameters.LogFile != "" {
+		file, err := logging.OpenFile(config.Parameters.LogFile, config.Parameters.RunID)
+		if err != nil {
+			return err
+		}
+		logFile = file
+	}
+
+	mode, _ := progress.ParseMode(config.Parameters.Progress)
+	return setupLogger(progress.Start(os.Stdout, mode))
+}
+
+// closeLogFile flushes the logs of the run to its log file, if any, before the process exits
+func closeLogFile() {
+	if logFile == nil {
+		return
+	}
+	if err := logFile.Sync(); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to sync log file: %v\n", err)
+	}
+	if err := logFile.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to close log file: %v\n", err)
+	}
+	logFile = nil
 }
 
 func newRootCmd() *cobra.Command {
@@ -41,32 +106,70 @@ func newRootCmd() *cobra.Command {
 		Use:   "secatest",
 		Short: "SECA Conformance Tests",
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			if cmd.Use == "run" {
-				if err := config.ProcessParameters(); err != nil {
-					return err
-				}
-				if err := config.InitClients(cmd.Context()); err != nil {
-					return err
-				}
+			switch cmd.Name() {
+			case "run":
+				return configureCommand(cmd, true, append(config.ClientParameters, config.ReportResultsPathParameter)...)
+			case "cleanup":
+				return configureCommand(cmd, true, config.ClientParameters...)
+			case "list":
+				// Listing checks the region only when the client settings are given
+				return configureCommand(cmd, clientParametersSet())
 			}
-			return nil
+			return setupLogger(os.Stdout)
 		},
 	}
 }
 
+func configureCommand(cmd *cobra.Command, initClients bool, required ...string) error {
+	if err := config.LoadSources(cmd.Flags()); err != nil {
+		return err
+	}
+	if err := config.ProcessParameters(required...); err != nil {
+		return err
+	}
+	if err := setupLogger(os.Stdout); err != nil {
+		return err
+	}
+	if !initClients {
+		return nil
+	}
+	return config.InitClients(cmd.Context())
+}
+
+func clientParametersSet() bool {
+	return config.Parameters.ProviderRegionV1 != "" && config.Parameters.ClientAuthToken != "" &&
+		config.Parameters.ClientRegion != "" && config.Parameters.ClientTenant != ""
+}
+
 func newRunCmd(m *testing.M) *cobra.Command {
 	return &cobra.Command{
 		Use:   "run",
 		Short: "Run Command",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			configureReports()
+			configureRunID()
+			if err := configureParallel(); err != nil {
+				return err
+			}
+
+			if err := setupRunLogger(); err != nil {
+				return err
+			}
+			slog.Info("Configured conformance run", "profile", config.Parameters.Profile)
+
+			// Recorded with the results, as the evidence of the configuration the run was made with
+			if err := config.WriteConfiguration(filepath.Join(config.Parameters.ReportResultsPath, attest.ConfigurationFile), cmd.Flags()); err != nil {
+				return err
+			}
 
 			// Run the test suites
 			code := m.Run()
-			if err := maybeWriteSummary(); err != nil {
-				slog.Error("Failed to write summary", "error", err)
-				os.Exit(1)
+			progress.Stop()
+
+			if failed := finishRun(cmd.Context()); failed != 0 {
+				code = failed
 			}
+			closeLogFile()
 			os.Exit(code)
 
 			return nil
@@ -74,80 +177,54 @@ func newRunCmd(m *testing.M) *cobra.Command {
 	}
 }
 
-func newListCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "list",
-		Short: "List Command",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			for _, name := range constants.AllSuiteNames {
-				println(string(name))
-			}
-			return nil
-		},
+// finishRun writes the outputs of the run once its suites ran, returning the exit code of the first one failing
+func finishRun(ctx context.Context) int {
+	if err := maybeWriteSummary(); err != nil {
+		slog.Error("Failed to write summary", "error", err)
+		return 1
 	}
-}
-
-func newReportCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "report",
-		Short: "Report Command",
-		RunE: func(cmd *cobra.Command, args []string) error {
-			// Extract the results path
-			var path string
-			if len(args) >= 1 {
-				path = args[0]
-			} else {
-				path = "./reports/results"
-			}
-
-			// Run allure report
-			cli := exec.Command("allure", "serve", path)
-			if err := cli.Start(); err != nil {
-				return err
-			}
-
-			return nil
-		},
+	if err := maybeExportTraces(ctx); err != nil {
+		slog.Error("Failed to export traces", "error", err)
+		return 1
+	}
+	if err := checkThresholds(); err != nil {
+		slog.Error("Failed the time thresholds", "error", err)
+		return 1
 	}
+	return 0
 }
 
 func initCommands(m *testing.M) *cobra.Command {
 	rootCmd := newRootCmd()
+	rootCmd.PersistentFlags().StringVar(&config.Parameters.LogLevel, "log.level", "info", "Log level: debug, info, warn or error")
+	rootCmd.PersistentFlags().StringVar(&config.Parameters.LogFormat, "log.format", string(logging.TextFormat), "Log format: text or json")
 
 	runCmd := newRunCmd(m)
 
-	runCmd.Flags().StringVar(&config.Parameters.ProviderRegionV1, "provider.region.v1", "", "Region V1 Provider Base URL")
-	runCmd.Flags().StringVar(&config.Parameters.ProviderAuthorizationV1, "provider.authorization.v1", "", "Authorization V1 Provider Base URL")
+	addClientFlags(runCmd)
 
-	runCmd.Flags().StringVar(&config.Parameters.ClientAuthToken, "client.auth.token", "", "Client Authentication Token")
-	runCmd.Flags().StringVar(&config.Parameters.ClientRegion, "client.region", "", "Client Region Name")
-	runCmd.Flags().StringVar(&config.Parameters.ClientTenant, "client.tenant", "", "Client Tenant Name")
+	addScenarioFlags(runCmd)
 
-	runCmd.Flags().StringVar(&config.Parameters.ScenariosFilter, "scenarios.filter", "", "Regular expression to filter scenarios to run")
-	runCmd.Flags().StringSliceVar(&config.Parameters.ScenariosUsers, "scenarios.users", nil, "Scenario Available Users")
-	runCmd.Flags().StringSliceVar(&config.Parameters.ScenariosAdditionalRegions, "scenarios.additional.regions", nil, "Scenario Additional Regions")
-	runCmd.Flags().StringVar(&config.Parameters.ScenariosCidr, "scenarios.cidr", "", "Scenario Available Network CIDR")
-	runCmd.Flags().StringVar(&config.Parameters.ScenariosPublicIps, "scenarios.public.ips", "", "Scenario Public IPs Range")
+	runCmd.Flags().IntVar(&config.Parameters.Parallel, "parallel", 1, "Maximum number of suites to run concurrently")
+	runCmd.Flags().StringVar(&config.Parameters.RunID, "run.id", "", "Run identifier stamped on every created resource, generated when empty")
+	runCmd.Flags().StringVar(&config.Parameters.Assertions, "assertions", string(config.StrictAssertions), "Verify steps stop at the first mismatching field (strict) or record them all as a diff (soft)")
+	runCmd.Flags().StringSliceVar(&config.Parameters.SloThresholds, "slo.thresholds", nil, "Time limits of the operations as <operation>.<metric>[.p<percentile>]=<duration>, e.g. ListNetworks.latency.p95=2s")
+	runCmd.Flags().BoolVar(&config.Parameters.SloBlocking, "slo.blocking", false, "Fail the steps and the run exceeding a threshold, instead of only reporting it")
+	runCmd.Flags().StringVar(&config.Parameters.Progress, "progress", string(progress.AutoMode), "Show the run progress: auto, tty, plain or off")
+	runCmd.Flags().StringVar(&config.Parameters.LogFile, "log.file", "", "Also write the logs to this file, {run.id} being replaced by the run ID")
 
-	runCmd.Flags().StringVar(&config.Parameters.ReportResultsPath, "report.results.path", "", "Report Results Path")
+	runCmd.Flags().StringVar(&config.Parameters.ReportResultsPath, config.ReportResultsPathParameter, "", "Report Results Path")
+	runCmd.Flags().BoolVar(&config.Parameters.ReportHar, "report.har", true, "Attach the HTTP exchanges of each scenario to its report as a HAR file")
+	runCmd.Flags().BoolVar(&config.Parameters.ValidateResponses, "validate.responses", true, "Validate every response of the providers against the SECA OpenAPI documents")
 	runCmd.Flags().StringVar(&config.Parameters.SummaryOutputPath, "output.summary.path", "", "Write JSON summary to this file after run")
-	runCmd.Flags().StringVar(&config.Parameters.SummaryFormat, "summary", "", "Print summary to stdout after run: json or text")
-
-	runCmd.Flags().BoolVar(&config.Parameters.MockEnabled, "mock.enabled", false, "Enable Mock Usage")
-	runCmd.Flags().StringVar(&config.Parameters.MockServerURL, "mock.server.url", "", "Mock Server URL")
-	runCmd.Flags().StringSliceVar(&config.Parameters.MockProviders, "mock.providers", nil, "Mock Available Providers")
-
-	runCmd.Flags().IntVar(&config.Parameters.BaseDelay, "retry.base.delay", 5, "Retry Base Delay in seconds")
-	runCmd.Flags().IntVar(&config.Parameters.BaseInterval, "retry.base.interval", 30, "Retry Base Interval in seconds")
-	runCmd.Flags().IntVar(&config.Parameters.MaxAttempts, "retry.max.attempts", 10, "Retry Max Attempts")
-
-	runCmd.MarkFlagsRequiredTogether(
-		"provider.region.v1",
-		"client.auth.token",
-		"client.region",
-		"client.tenant",
-		"report.results.path",
-	)
+	runCmd.Flags().StringVar(&config.Parameters.SummaryJUnitOutputPath, "output.summary.junit.path", "", "Write JUnit XML summary to this file after run")
+	runCmd.Flags().StringVar(&config.Parameters.SummaryFormat, "summary", "", "Print summary to stdout after run: json, text or junit")
+	runCmd.Flags().StringVar(&config.Parameters.SummaryParameters, "summary.parameters", string(report.IncludeParameters), "Step parameters and attachments in the summary: include, redact or omit")
+
+	addOtlpFlags(runCmd)
+
+	addMockFlags(runCmd)
+	addRetryFlags(runCmd)
 
 	rootCmd.AddCommand(runCmd)
 
@@ -155,14 +232,147 @@ func initCommands(m *testing.M) *cobra.Command {
 	rootCmd.AddCommand(reportCmd)
 
 	listCmd := newListCmd()
+	addClientFlags(listCmd)
+	addScenarioFlags(listCmd)
+	addMockFlags(listCmd)
+	addRetryFlags(listCmd)
 	rootCmd.AddCommand(listCmd)
 
 	summaryCmd := newSummaryCmd()
 	rootCmd.AddCommand(summaryCmd)
 
+	diffCmd := newDiffCmd()
+	rootCmd.AddCommand(diffCmd)
+
+	coverageCmd := newCoverageCmd()
+	rootCmd.AddCommand(coverageCmd)
+
+	tracesCmd := newTracesCmd()
+	rootCmd.AddCommand(tracesCmd)
+
+	attestCmd := newAttestCmd()
+	rootCmd.AddCommand(attestCmd)
+
+	cleanupCmd := newCleanupCmd()
+	addClientFlags(cleanupCmd)
+	addRetryFlags(cleanupCmd)
+	cleanupCmd.Flags().StringVar(&config.Parameters.RunID, "run.id", "", "Only delete the resources created by this run")
+	rootCmd.AddCommand(cleanupCmd)
+
 	return rootCmd
 }
 
+func addClientFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&config.Parameters.ConfigFile, config.ConfigFlag, "", "YAML or JSON configuration file, overridden by env variables and flags")
+	cmd.Flags().StringVar(&config.Parameters.Profile, config.ProfileFlag, "", "Profile of the configuration file overriding its top-level settings")
+
+	cmd.Flags().StringVar(&config.Parameters.ProviderRegionV1, config.ProviderRegionV1Parameter, "", "Region V1 Provider Base URL")
+	cmd.Flags().StringVar(&config.Parameters.ProviderAuthorizationV1, "provider.authorization.v1", "", "Authorization V1 Provider Base URL")
+
+	cmd.Flags().StringVar(&config.Parameters.ClientAuthToken, config.ClientAuthTokenParameter, "", "Client Authentication Token")
+	cmd.Flags().StringVar(&config.Parameters.ClientRegion, config.ClientRegionParameter, "", "Client Region Name")
+	cmd.Flags().StringVar(&config.Parameters.ClientTenant, config.ClientTenantParameter, "", "Client Tenant Name")
+}
+
+func addScenarioFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&config.Parameters.ScenariosFilter, "scenarios.filter", "", "Regular expression to filter scenarios to run")
+	cmd.Flags().StringArrayVar(&config.Parameters.ScenariosSelect, "select", nil, "Run the scenarios matching any of these tag expressions, e.g. provider=seca.network,kind=Error")
+	cmd.Flags().StringArrayVar(&config.Parameters.ScenariosExclude, "exclude", nil, "Skip the scenarios matching any of these tag expressions, e.g. resource=instance")
+	cmd.Flags().StringVar(&config.Parameters.Level, "level", "", "Run the scenarios mandatory at this conformance level and report its verdict: core, extended or full")
+	cmd.Flags().StringSliceVar(&config.Parameters.ScenariosUsers, "scenarios.users", nil, "Scenario Available Users")
+	cmd.Flags().StringSliceVar(&config.Parameters.ScenariosAdditionalRegions, "scenarios.additional.regions", nil, "Scenario Additional Regions")
+	cmd.Flags().StringVar(&config.Parameters.ScenariosCidr, "scenarios.cidr", "", "Scenario Available Network CIDR")
+	cmd.Flags().StringVar(&config.Parameters.ScenariosPublicIps, "scenarios.public.ips", "", "Scenario Public IPs Range")
+}
+
+func addMockFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&config.Parameters.MockEnabled, "mock.enabled", false, "Enable Mock Usage")
+	cmd.Flags().StringVar(&config.Parameters.MockServerURL, "mock.server.url", "", "Mock Server URL")
+	cmd.Flags().StringSliceVar(&config.Parameters.MockProviders, "mock.providers", nil, "Mock Available Providers")
+}
+
+func addRetryFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&config.Parameters.BaseDelay, "retry.base.delay", 5, "Retry Base Delay in seconds")
+	cmd.Flags().IntVar(&config.Parameters.BaseInterval, "retry.base.interval", 30, "Retry Base Interval in seconds")
+	cmd.Flags().IntVar(&config.Parameters.MaxAttempts, "retry.max.attempts", 10, "Retry Max Attempts")
+}
+
+func configureRunID() {
+	if config.Parameters.RunID == "" {
+		config.Parameters.RunID = generators.GenerateRunID()
+	}
+
+	builders.SetCommonLabels(schema.Labels{
+		constants.ManagedByLabel: constants.ManagedBySecatestLabel,
+		constants.RunIDLabel:     config.Parameters.RunID,
+	})
+	builders.SetCommonAnnotations(schema.Annotations{constants.RunIDAnnotation: config.Parameters.RunID})
+}
+
+func configureParallel() error {
+	// Suites run as subtests, so the test runner limits how many run at once
+	return flag.Set("test.parallel", strconv.Itoa(max(config.Parameters.Parallel, 1)))
+}
+
+// markParallel lets the test run concurrently with its siblings, when parallel execution is enabled
+func markParallel(t *testing.T) {
+	if config.Parameters.Parallel > 1 {
+		t.Parallel()
+	}
+}
+
+// conformanceSuite is a suite able to report itself as skipped when its requirements are unmet
+type conformanceSuite interface {
+	runner.TestSuite
+	CanRun(selector *selector.Selector) bool
+	Metadata(selector *selector.Selector) suites.Metadata
+	UnmetRequirements() []string
+	SkipScenario(t provider.T)
+}
+
+// allV1Suites builds every suite, in listing order
+func allV1Suites() []conformanceSuite {
+	return slices.Concat(
+		authorizationV1Suites(),
+		regionV1Suites(),
+		workspaceV1Suites(),
+		computeV1Suites(),
+		storageV1Suites(),
+		networkV1Suites(),
+		usageV1Suites(),
+	)
+}
+
+// runSuites runs the suites selected for the run
+func runSuites(t *testing.T, testSuites []conformanceSuite) {
+	for _, testSuite := range testSuites {
+		if testSuite.CanRun(config.Parameters.ScenariosSelector) {
+			runSuite(t, testSuite)
+		}
+	}
+}
+
+// runSuite runs the suite, concurrently with the other suites when parallel execution is enabled
+func runSuite(t *testing.T, testSuite conformanceSuite) {
+	name := reflect.Indirect(reflect.ValueOf(testSuite)).Type().Name()
+
+	// Report the suite as skipped, instead of failing on the missing providers or inputs
+	if len(testSuite.UnmetRequirements()) > 0 {
+		runner.Run(t, name, testSuite.SkipScenario)
+		return
+	}
+
+	if config.Parameters.Parallel <= 1 {
+		suite.RunSuite(t, testSuite)
+		return
+	}
+
+	t.Run(name, func(t *testing.T) {
+		t.Parallel()
+		suite.RunSuite(t, testSuite)
+	})
+}
+
 func configureReports() {
 	resultsPath := config.Parameters.ReportResultsPath
 
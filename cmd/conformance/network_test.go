@@ -6,14 +6,16 @@ import (
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites/network"
-	"github.com/ozontech/allure-go/pkg/framework/suite"
 )
 
 func TestNetworkV1Suites(t *testing.T) {
-	regionalTestSuite := suites.CreateRegionalTestSuite(config.Parameters, config.Clients)
+	markParallel(t)
+	runSuites(t, networkV1Suites())
+}
 
+func networkV1Suites() []conformanceSuite {
 	// Provider LifeCycle Suite
-	providerLifeCycleSuite := network.CreateProviderLifeCycleV1TestSuite(regionalTestSuite,
+	providerLifeCycleSuite := network.CreateProviderLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.ProviderLifeCycleV1Config{
 			NetworkCidr:    config.Parameters.ScenariosCidr,
 			PublicIpsRange: config.Parameters.ScenariosPublicIps,
@@ -23,12 +25,9 @@ func TestNetworkV1Suites(t *testing.T) {
 			NetworkSkus:    config.Clients.NetworkSkus,
 		},
 	)
-	if providerLifeCycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, providerLifeCycleSuite)
-	}
 
 	// Provider Queries Suite
-	providerQueriesSuite := network.CreateProviderQueriesV1TestSuite(regionalTestSuite,
+	providerQueriesSuite := network.CreateProviderQueriesV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.ProviderQueriesV1Config{
 			NetworkCidr:    config.Parameters.ScenariosCidr,
 			PublicIpsRange: config.Parameters.ScenariosPublicIps,
@@ -38,23 +37,17 @@ func TestNetworkV1Suites(t *testing.T) {
 			NetworkSkus:    config.Clients.NetworkSkus,
 		},
 	)
-	if providerQueriesSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, providerQueriesSuite)
-	}
 
 	// Network Lifecycle Suite
-	networkLifecycleSuite := network.CreateNetworkLifeCycleV1TestSuite(regionalTestSuite,
+	networkLifecycleSuite := network.CreateNetworkLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.NetworkLifeCycleV1Config{
 			NetworkCidr: config.Parameters.ScenariosCidr,
 			NetworkSkus: config.Clients.NetworkSkus,
 		},
 	)
-	if networkLifecycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, networkLifecycleSuite)
-	}
 
 	// Nic Lifecycle Suite
-	nicLifecycleSuite := network.CreateNicLifeCycleV1TestSuite(regionalTestSuite,
+	nicLifecycleSuite := network.CreateNicLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.NicLifeCycleV1Config{
 			NetworkCidr:    config.Parameters.ScenariosCidr,
 			PublicIpsRange: config.Parameters.ScenariosPublicIps,
@@ -62,12 +55,9 @@ func TestNetworkV1Suites(t *testing.T) {
 			NetworkSkus:    config.Clients.NetworkSkus,
 		},
 	)
-	if nicLifecycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, nicLifecycleSuite)
-	}
 
 	// Route Table Lifecycle Suite
-	routeTableLifecycleSuite := network.CreateRouteTableLifeCycleV1TestSuite(regionalTestSuite,
+	routeTableLifecycleSuite := network.CreateRouteTableLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.RouteTableLifeCycleV1Config{
 			NetworkCidr:    config.Parameters.ScenariosCidr,
 			PublicIpsRange: config.Parameters.ScenariosPublicIps,
@@ -75,81 +65,54 @@ func TestNetworkV1Suites(t *testing.T) {
 			NetworkSkus:    config.Clients.NetworkSkus,
 		},
 	)
-	if routeTableLifecycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, routeTableLifecycleSuite)
-	}
 
 	// Internet Gateway Lifecycle Suite
-	internetGatewayLifecycleSuite := network.CreateInternetGatewayLifeCycleV1TestSuite(regionalTestSuite)
-	if internetGatewayLifecycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, internetGatewayLifecycleSuite)
-	}
+	internetGatewayLifecycleSuite := network.CreateInternetGatewayLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients))
 
 	// Subnet Lifecycle Suite
-	subnetLifecycleSuite := network.CreateSubnetLifeCycleV1TestSuite(regionalTestSuite,
+	subnetLifecycleSuite := network.CreateSubnetLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.SubnetLifeCycleV1Config{
 			NetworkCidr: config.Parameters.ScenariosCidr,
 			RegionZones: config.Clients.RegionZones,
 			NetworkSkus: config.Clients.NetworkSkus,
 		},
 	)
-	if subnetLifecycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, subnetLifecycleSuite)
-	}
 
 	// Public IP Lifecycle Suite
-	publicIpLifecycleSuite := network.CreatePublicIpLifeCycleV1TestSuite(regionalTestSuite,
+	publicIpLifecycleSuite := network.CreatePublicIpLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.PublicIpLifeCycleV1Config{
 			PublicIpsRange: config.Parameters.ScenariosPublicIps,
 			NetworkSkus:    config.Clients.NetworkSkus,
 		},
 	)
-	if publicIpLifecycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, publicIpLifecycleSuite)
-	}
 
 	// Security Group Rule Lifecycle Suite
-	securityGroupRuleLifecycleSuite := network.CreateSecurityGroupRuleLifeCycleV1TestSuite(regionalTestSuite)
-	if securityGroupRuleLifecycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, securityGroupRuleLifecycleSuite)
-	}
+	securityGroupRuleLifecycleSuite := network.CreateSecurityGroupRuleLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients))
 
 	// Security Group Lifecycle Suite
-	securityGroupLifecycleSuite := network.CreateSecurityGroupLifeCycleV1TestSuite(regionalTestSuite)
-	if securityGroupLifecycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, securityGroupLifecycleSuite)
-	}
+	securityGroupLifecycleSuite := network.CreateSecurityGroupLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients))
 
 	// Network Constraints Suite
-	networkConstraintsSuite := network.CreateNetworkConstraintsValidationV1TestSuite(regionalTestSuite,
+	networkConstraintsSuite := network.CreateNetworkConstraintsValidationV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.NetworkLifeCycleV1Config{
 			NetworkCidr: config.Parameters.ScenariosCidr,
 			NetworkSkus: config.Clients.NetworkSkus,
 		},
 	)
-	if networkConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, networkConstraintsSuite)
-	}
 
 	// Internet Gateway Constraints Suite
-	internetGatewayConstraintsSuite := network.CreateInternetGatewayConstraintsValidationV1TestSuite(regionalTestSuite)
-	if internetGatewayConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, internetGatewayConstraintsSuite)
-	}
+	internetGatewayConstraintsSuite := network.CreateInternetGatewayConstraintsValidationV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients))
 
 	// Public IP Constraints Suite
-	publicIpConstraintsSuite := network.CreatePublicIpConstraintsValidationV1TestSuite(regionalTestSuite,
+	publicIpConstraintsSuite := network.CreatePublicIpConstraintsValidationV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.PublicIpLifeCycleV1Config{
 			PublicIpsRange: config.Parameters.ScenariosPublicIps,
 			NetworkSkus:    config.Clients.NetworkSkus,
 		},
 	)
-	if publicIpConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, publicIpConstraintsSuite)
-	}
 
 	// Nic Constraints Suite
-	nicConstraintsSuite := network.CreateNicConstraintsValidationV1TestSuite(regionalTestSuite,
+	nicConstraintsSuite := network.CreateNicConstraintsValidationV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.NicLifeCycleV1Config{
 			NetworkCidr:    config.Parameters.ScenariosCidr,
 			PublicIpsRange: config.Parameters.ScenariosPublicIps,
@@ -157,24 +120,15 @@ func TestNetworkV1Suites(t *testing.T) {
 			NetworkSkus:    config.Clients.NetworkSkus,
 		},
 	)
-	if nicConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, nicConstraintsSuite)
-	}
 
 	// Security Group Constraints Suite
-	securityGroupConstraintsSuite := network.CreateSecurityGroupConstraintsValidationV1TestSuite(regionalTestSuite)
-	if securityGroupConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, securityGroupConstraintsSuite)
-	}
+	securityGroupConstraintsSuite := network.CreateSecurityGroupConstraintsValidationV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients))
 
 	// Security Group Rule Constraints Suite
-	securityGroupRuleConstraintsSuite := network.CreateSecurityGroupRuleConstraintsValidationV1TestSuite(regionalTestSuite)
-	if securityGroupRuleConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, securityGroupRuleConstraintsSuite)
-	}
+	securityGroupRuleConstraintsSuite := network.CreateSecurityGroupRuleConstraintsValidationV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients))
 
 	// Route Table Constraints Suite
-	routeTableConstraintsSuite := network.CreateRouteTableConstraintsValidationV1TestSuite(regionalTestSuite,
+	routeTableConstraintsSuite := network.CreateRouteTableConstraintsValidationV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.RouteTableLifeCycleV1Config{
 			NetworkCidr:    config.Parameters.ScenariosCidr,
 			PublicIpsRange: config.Parameters.ScenariosPublicIps,
@@ -182,89 +136,62 @@ func TestNetworkV1Suites(t *testing.T) {
 			NetworkSkus:    config.Clients.NetworkSkus,
 		},
 	)
-	if routeTableConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, routeTableConstraintsSuite)
-	}
 
 	// Subnet Constraints Suite
-	subnetConstraintsSuite := network.CreateSubnetConstraintsValidationV1TestSuite(regionalTestSuite,
+	subnetConstraintsSuite := network.CreateSubnetConstraintsValidationV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.SubnetLifeCycleV1Config{
 			NetworkCidr: config.Parameters.ScenariosCidr,
 			RegionZones: config.Clients.RegionZones,
 			NetworkSkus: config.Clients.NetworkSkus,
 		},
 	)
-	if subnetConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, subnetConstraintsSuite)
-	}
 
 	// ── Error Suites ──────────────────────────────────────────────────────────
 
 	// Internet Gateway Error Suite
-	internetGatewayErrorSuite := network.CreateInternetGatewayErrorV1TestSuite(regionalTestSuite)
-	if internetGatewayErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, internetGatewayErrorSuite)
-	}
+	internetGatewayErrorSuite := network.CreateInternetGatewayErrorV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients))
 
 	// Security Group Rule Error Suite
-	securityGroupRuleErrorSuite := network.CreateSecurityGroupRuleErrorV1TestSuite(regionalTestSuite)
-	if securityGroupRuleErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, securityGroupRuleErrorSuite)
-	}
+	securityGroupRuleErrorSuite := network.CreateSecurityGroupRuleErrorV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients))
 
 	// Security Group Error Suite
-	securityGroupErrorSuite := network.CreateSecurityGroupErrorV1TestSuite(regionalTestSuite)
-	if securityGroupErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, securityGroupErrorSuite)
-	}
+	securityGroupErrorSuite := network.CreateSecurityGroupErrorV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients))
 
 	// Public IP Error Suite
-	publicIpErrorSuite := network.CreatePublicIpErrorV1TestSuite(regionalTestSuite,
+	publicIpErrorSuite := network.CreatePublicIpErrorV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.PublicIpLifeCycleV1Config{
 			PublicIpsRange: config.Parameters.ScenariosPublicIps,
 			NetworkSkus:    config.Clients.NetworkSkus,
 		},
 	)
-	if publicIpErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, publicIpErrorSuite)
-	}
 
 	// Network Error Suite
-	networkErrorSuite := network.CreateNetworkErrorV1TestSuite(regionalTestSuite,
+	networkErrorSuite := network.CreateNetworkErrorV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.NetworkErrorV1Config{
 			NetworkCidr: config.Parameters.ScenariosCidr,
 			NetworkSkus: config.Clients.NetworkSkus,
 		},
 	)
-	if networkErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, networkErrorSuite)
-	}
 
 	// Route Table Error Suite
-	routeTableErrorSuite := network.CreateRouteTableErrorV1TestSuite(regionalTestSuite,
+	routeTableErrorSuite := network.CreateRouteTableErrorV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.RouteTableErrorV1Config{
 			NetworkCidr: config.Parameters.ScenariosCidr,
 			NetworkSkus: config.Clients.NetworkSkus,
 		},
 	)
-	if routeTableErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, routeTableErrorSuite)
-	}
 
 	// Subnet Error Suite
-	subnetErrorSuite := network.CreateSubnetErrorV1TestSuite(regionalTestSuite,
+	subnetErrorSuite := network.CreateSubnetErrorV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.SubnetLifeCycleV1Config{
 			NetworkCidr: config.Parameters.ScenariosCidr,
 			RegionZones: config.Clients.RegionZones,
 			NetworkSkus: config.Clients.NetworkSkus,
 		},
 	)
-	if subnetErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, subnetErrorSuite)
-	}
 
 	// Nic Error Suite
-	nicErrorSuite := network.CreateNicErrorV1TestSuite(regionalTestSuite,
+	nicErrorSuite := network.CreateNicErrorV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&network.NicLifeCycleV1Config{
 			NetworkCidr:    config.Parameters.ScenariosCidr,
 			PublicIpsRange: config.Parameters.ScenariosPublicIps,
@@ -272,7 +199,33 @@ func TestNetworkV1Suites(t *testing.T) {
 			NetworkSkus:    config.Clients.NetworkSkus,
 		},
 	)
-	if nicErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, nicErrorSuite)
+
+	return []conformanceSuite{
+		providerLifeCycleSuite,
+		providerQueriesSuite,
+		networkLifecycleSuite,
+		nicLifecycleSuite,
+		routeTableLifecycleSuite,
+		internetGatewayLifecycleSuite,
+		subnetLifecycleSuite,
+		publicIpLifecycleSuite,
+		securityGroupRuleLifecycleSuite,
+		securityGroupLifecycleSuite,
+		networkConstraintsSuite,
+		internetGatewayConstraintsSuite,
+		publicIpConstraintsSuite,
+		nicConstraintsSuite,
+		securityGroupConstraintsSuite,
+		securityGroupRuleConstraintsSuite,
+		routeTableConstraintsSuite,
+		subnetConstraintsSuite,
+		internetGatewayErrorSuite,
+		securityGroupRuleErrorSuite,
+		securityGroupErrorSuite,
+		publicIpErrorSuite,
+		networkErrorSuite,
+		routeTableErrorSuite,
+		subnetErrorSuite,
+		nicErrorSuite,
 	}
 }
@@ -6,16 +6,18 @@ import (
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites/region"
-
-	"github.com/ozontech/allure-go/pkg/framework/suite"
 )
 
 func TestRegionV1Suites(t *testing.T) {
-	globalTestSuite := suites.CreateGlobalTestSuite(config.Parameters, config.Clients)
+	markParallel(t)
+	runSuites(t, regionV1Suites())
+}
 
+func regionV1Suites() []conformanceSuite {
 	// Provider Queries Suite
-	providerQueriesSuite := region.CreateProviderQueriesV1TestSuite(globalTestSuite, config.Parameters.ClientRegion, config.Parameters.ScenariosAdditionalRegions)
-	if providerQueriesSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, providerQueriesSuite)
+	providerQueriesSuite := region.CreateProviderQueriesV1TestSuite(suites.CreateGlobalTestSuite(config.Parameters, config.Clients), config.Parameters.ClientRegion, config.Parameters.ScenariosAdditionalRegions)
+
+	return []conformanceSuite{
+		providerQueriesSuite,
 	}
 }
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/report"
+	"github.com/spf13/cobra"
+)
+
+func newReportCmd() *cobra.Command {
+	var format, output, parameters string
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report Command",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Extract the results path
+			var path string
+			if len(args) >= 1 {
+				path = args[0]
+			} else {
+				path = "./reports/results"
+			}
+
+			switch format {
+			case "html":
+				return writeHTMLReport(path, output, parameters)
+			case "allure":
+			default:
+				return fmt.Errorf("unknown report format %q, must be one of allure, html", format)
+			}
+
+			// Run allure report
+			cli := exec.Command("allure", "serve", path)
+			if err := cli.Start(); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&format, "format", "allure", "Report format: allure, served by the Allure CLI, or html, written as a single static file")
+	cmd.Flags().StringVar(&output, "output", "conformance-report.html", "File the html report is written to")
+	addParametersFlag(cmd, &parameters)
+	return cmd
+}
+
+func writeHTMLReport(resultsPath string, output string, parameters string) error {
+	s, err := buildSummary(resultsPath, parameters)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("creating html report: %w", err)
+	}
+	defer file.Close()
+
+	if err := report.WriteHTML(file, s); err != nil {
+		return fmt.Errorf("writing html report: %w", err)
+	}
+	slog.Info("Wrote html report", "path", output)
+	return file.Close()
+}
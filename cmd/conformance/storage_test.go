@@ -6,57 +6,46 @@ import (
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites/storage"
-	"github.com/ozontech/allure-go/pkg/framework/suite"
 )
 
 func TestStorageV1Suites(t *testing.T) {
-	regionalTestSuite := suites.CreateRegionalTestSuite(config.Parameters, config.Clients)
+	markParallel(t)
+	runSuites(t, storageV1Suites())
+}
 
+func storageV1Suites() []conformanceSuite {
 	// Provider LifeCycle Suite
-	providerLifeCycleSuite := storage.CreateProviderLifeCycleV1TestSuite(regionalTestSuite, config.Clients.StorageSkus)
-	if providerLifeCycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, providerLifeCycleSuite)
-	}
+	providerLifeCycleSuite := storage.CreateProviderLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients), config.Clients.StorageSkus)
 
 	// Provider Queries Suite
-	providerQueriesSuite := storage.CreateProviderQueriesV1TestSuite(regionalTestSuite, config.Clients.StorageSkus)
-	if providerQueriesSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, providerQueriesSuite)
-	}
+	providerQueriesSuite := storage.CreateProviderQueriesV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients), config.Clients.StorageSkus)
 
 	// Block Strage LifeCycle Suite
-	blockStorageLifeCycleSuite := storage.CreateBlockStorageLifeCycleV1TestSuite(regionalTestSuite, config.Clients.StorageSkus)
-	if blockStorageLifeCycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, blockStorageLifeCycleSuite)
-	}
+	blockStorageLifeCycleSuite := storage.CreateBlockStorageLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients), config.Clients.StorageSkus)
 
 	// Block Storage Constraints Violations Suite
-	blockStorageConstraintsSuite := storage.CreateBlockStorageConstraintsValidationV1TestSuite(regionalTestSuite, config.Clients.StorageSkus)
-	if blockStorageConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, blockStorageConstraintsSuite)
-	}
+	blockStorageConstraintsSuite := storage.CreateBlockStorageConstraintsValidationV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients), config.Clients.StorageSkus)
 
 	// Block Storage Error Suite
-	blockStorageErrorSuite := storage.CreateBlockStorageErrorV1TestSuite(regionalTestSuite, config.Clients.StorageSkus)
-	if blockStorageErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, blockStorageErrorSuite)
-	}
+	blockStorageErrorSuite := storage.CreateBlockStorageErrorV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients), config.Clients.StorageSkus)
 
 	// Image LifeCycle Suite
-	imageLifeCycleSuite := storage.CreateImageLifeCycleV1TestSuite(regionalTestSuite, config.Clients.StorageSkus)
-	if imageLifeCycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, imageLifeCycleSuite)
-	}
+	imageLifeCycleSuite := storage.CreateImageLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients), config.Clients.StorageSkus)
 
 	// Image Constraints Violations Suite
-	imageConstraintsSuite := storage.CreateImageConstraintsValidationV1TestSuite(regionalTestSuite, config.Clients.StorageSkus)
-	if imageConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, imageConstraintsSuite)
-	}
+	imageConstraintsSuite := storage.CreateImageConstraintsValidationV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients), config.Clients.StorageSkus)
 
 	// Image Error Suite
-	imageErrorSuite := storage.CreateImageErrorV1TestSuite(regionalTestSuite, config.Clients.StorageSkus)
-	if imageErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, imageErrorSuite)
+	imageErrorSuite := storage.CreateImageErrorV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients), config.Clients.StorageSkus)
+
+	return []conformanceSuite{
+		providerLifeCycleSuite,
+		providerQueriesSuite,
+		blockStorageLifeCycleSuite,
+		blockStorageConstraintsSuite,
+		blockStorageErrorSuite,
+		imageLifeCycleSuite,
+		imageConstraintsSuite,
+		imageErrorSuite,
 	}
 }
@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
@@ -11,54 +12,40 @@ import (
 )
 
 func newSummaryCmd() *cobra.Command {
-	var format string
+	var format, parameters string
 	cmd := &cobra.Command{
 		Use:   "summary <results-path>",
 		Short: "Print a structured summary of Allure result files",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			s, err := report.BuildSummary(args[0])
+			s, err := buildSummary(args[0], parameters)
 			if err != nil {
-				return fmt.Errorf("building summary: %w", err)
-			}
-			switch format {
-			case "text":
-				return report.WriteText(os.Stdout, s)
-			default:
-				enc := json.NewEncoder(os.Stdout)
-				enc.SetIndent("", "  ")
-				return enc.Encode(s)
+				return err
 			}
+			return writeSummary(os.Stdout, s, format)
 		},
 	}
-	cmd.Flags().StringVar(&format, "format", "json", "Output format: json or text")
+	cmd.Flags().StringVar(&format, "format", "json", "Output format: json, text or junit")
+	addParametersFlag(cmd, &parameters)
 	return cmd
 }
 
 func maybeWriteSummary() error {
 	needFile := config.Parameters.SummaryOutputPath != ""
+	needJUnitFile := config.Parameters.SummaryJUnitOutputPath != ""
 	needStdout := config.Parameters.SummaryFormat != ""
-	if !needFile && !needStdout {
+	if !needFile && !needJUnitFile && !needStdout {
 		return nil
 	}
 
-	s, err := report.BuildSummary(config.Parameters.ReportResultsPath)
+	s, err := buildSummary(config.Parameters.ReportResultsPath, config.Parameters.SummaryParameters)
 	if err != nil {
-		return fmt.Errorf("building summary: %w", err)
+		return err
 	}
 
 	if needStdout {
-		switch config.Parameters.SummaryFormat {
-		case "text":
-			if err := report.WriteText(os.Stdout, s); err != nil {
-				return fmt.Errorf("writing text summary: %w", err)
-			}
-		default:
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			if err := enc.Encode(s); err != nil {
-				return fmt.Errorf("encoding json summary: %w", err)
-			}
+		if err := writeSummary(os.Stdout, s, config.Parameters.SummaryFormat); err != nil {
+			return fmt.Errorf("writing %s summary: %w", config.Parameters.SummaryFormat, err)
 		}
 	}
 
@@ -72,5 +59,75 @@ func maybeWriteSummary() error {
 		}
 	}
 
+	// The stdout mixes the summary with the logs of the run, a file keeps the XML readable by the CI servers
+	if needJUnitFile {
+		if err := writeSummaryFile(config.Parameters.SummaryJUnitOutputPath, s, "junit"); err != nil {
+			return fmt.Errorf("writing junit summary file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// checkThresholds fails the run exceeding a blocking threshold, since the percentiles are only known once every scenario ran
+func checkThresholds() error {
+	if !config.Parameters.SloBlocking || len(config.Parameters.Thresholds) == 0 {
+		return nil
+	}
+
+	s, err := report.BuildSummary(config.Parameters.ReportResultsPath)
+	if err != nil {
+		return fmt.Errorf("building summary: %w", err)
+	}
+	if exceeded := s.ExceededThresholds(); exceeded > 0 {
+		return fmt.Errorf("%d thresholds exceeded, see the operations of the summary", exceeded)
+	}
 	return nil
 }
+
+func addParametersFlag(cmd *cobra.Command, parameters *string) {
+	cmd.Flags().StringVar(parameters, "parameters", string(report.IncludeParameters),
+		"Step parameters and attachments: include, redact their tenant and secret values, or omit")
+}
+
+// buildSummary reads the results, keeping, redacting or omitting the step parameters
+func buildSummary(resultsPath string, parameters string) (*report.Summary, error) {
+	mode, err := report.ParseParametersMode(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := report.BuildSummary(resultsPath)
+	if err != nil {
+		return nil, fmt.Errorf("building summary: %w", err)
+	}
+	s.ApplyParametersMode(mode)
+	return s, nil
+}
+
+func writeSummaryFile(path string, s *report.Summary, format string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := writeSummary(file, s, format); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func writeSummary(w io.Writer, s *report.Summary, format string) error {
+	switch format {
+	case "text":
+		return report.WriteText(w, s)
+	case "junit":
+		return report.WriteJUnit(w, s)
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(s)
+	default:
+		return fmt.Errorf("unknown summary format %q, must be one of json, text, junit", format)
+	}
+}
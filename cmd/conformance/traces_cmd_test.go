@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
+	"github.com/eu-sovereign-cloud/conformance/internal/telemetry"
+	"github.com/spf13/cobra"
+)
+
+func newTracesCmd() *cobra.Command {
+	var parameters string
+	cmd := &cobra.Command{
+		Use:   "traces <results-path>",
+		Short: "Export the Allure results as OpenTelemetry traces, to an OTLP collector or an OTLP/JSON file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.Parameters.OtlpEndpoint == "" && config.Parameters.OtlpFile == "" {
+				return errors.New("missing otlp.endpoint or otlp.file: set where to export the traces")
+			}
+			return exportTraces(cmd.Context(), args[0], parameters)
+		},
+	}
+	addOtlpFlags(cmd)
+	addParametersFlag(cmd, &parameters)
+	return cmd
+}
+
+func addOtlpFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&config.Parameters.OtlpEndpoint, "otlp.endpoint", "", "OTLP/HTTP collector receiving the traces, e.g. http://localhost:4318")
+	cmd.Flags().StringSliceVar(&config.Parameters.OtlpHeaders, config.OtlpHeadersParameter, nil, "Headers sent to the OTLP collector, as name=value pairs")
+	cmd.Flags().StringVar(&config.Parameters.OtlpFile, "otlp.file", "", "Write the traces as OTLP/JSON to this file, when no collector is set or it cannot be reached")
+}
+
+// maybeExportTraces exports the traces of the run, when a collector or a file is configured
+func maybeExportTraces(ctx context.Context) error {
+	if config.Parameters.OtlpEndpoint == "" && config.Parameters.OtlpFile == "" {
+		return nil
+	}
+	return exportTraces(ctx, config.Parameters.ReportResultsPath, config.Parameters.SummaryParameters)
+}
+
+func exportTraces(ctx context.Context, resultsPath string, parameters string) error {
+	headers, err := telemetry.ParseHeaders(config.Parameters.OtlpHeaders)
+	if err != nil {
+		return err
+	}
+	s, err := buildSummary(resultsPath, parameters)
+	if err != nil {
+		return err
+	}
+
+	exporter := telemetry.Exporter{
+		Endpoint: config.Parameters.OtlpEndpoint,
+		Headers:  headers,
+		File:     config.Parameters.OtlpFile,
+	}
+	written, err := exporter.Export(ctx, telemetry.BuildTraces(s))
+	switch {
+	case written && err != nil:
+		slog.Warn("Wrote traces to file instead of the collector", "file", exporter.File, "error", err)
+	case written:
+		slog.Info("Wrote traces to file", "file", exporter.File, "traces", len(s.Scenarios))
+	case err != nil:
+		return err
+	default:
+		slog.Info("Exported traces", "endpoint", exporter.Endpoint, "traces", len(s.Scenarios))
+	}
+	return nil
+}
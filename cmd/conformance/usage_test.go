@@ -6,15 +6,16 @@ import (
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites/usage"
-	"github.com/ozontech/allure-go/pkg/framework/suite"
 )
 
 func TestUsageV1Suites(t *testing.T) {
-	mixedTestSuite := suites.CreateMixedTestSuite(config.Parameters, config.Clients)
-	regionalTestSuite := suites.CreateRegionalTestSuite(config.Parameters, config.Clients)
+	markParallel(t)
+	runSuites(t, usageV1Suites())
+}
 
+func usageV1Suites() []conformanceSuite {
 	// Foundation Providers Suite
-	foundationProvidersSuite := usage.CreateFoundationProvidersV1TestSuite(mixedTestSuite,
+	foundationProvidersSuite := usage.CreateFoundationProvidersV1TestSuite(suites.CreateMixedTestSuite(config.Parameters, config.Clients),
 		&usage.FoundationProvidersV1Config{
 			Users:          config.Parameters.ScenariosUsers,
 			NetworkCidr:    config.Parameters.ScenariosCidr,
@@ -25,12 +26,9 @@ func TestUsageV1Suites(t *testing.T) {
 			NetworkSkus:    config.Clients.NetworkSkus,
 		},
 	)
-	if foundationProvidersSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, foundationProvidersSuite)
-	}
 
 	// Multi-Workspace Isolation Suite
-	multiWorkspaceIsolationSuite := usage.CreateMultiWorkspaceIsolationV1TestSuite(mixedTestSuite,
+	multiWorkspaceIsolationSuite := usage.CreateMultiWorkspaceIsolationV1TestSuite(suites.CreateMixedTestSuite(config.Parameters, config.Clients),
 		&usage.MultiWorkspaceIsolationV1Config{
 			Users:          config.Parameters.ScenariosUsers,
 			NetworkCidr:    config.Parameters.ScenariosCidr,
@@ -41,24 +39,18 @@ func TestUsageV1Suites(t *testing.T) {
 			NetworkSkus:    config.Clients.NetworkSkus,
 		},
 	)
-	if multiWorkspaceIsolationSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, multiWorkspaceIsolationSuite)
-	}
 
 	// HA Multi-Zone Suite
-	haMultiZoneSuite := usage.CreateHaMultiZoneV1TestSuite(regionalTestSuite,
+	haMultiZoneSuite := usage.CreateHaMultiZoneV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&usage.HaMultiZoneV1Config{
 			RegionZones:  config.Clients.RegionZones,
 			InstanceSkus: config.Clients.InstanceSkus,
 			StorageSkus:  config.Clients.StorageSkus,
 		},
 	)
-	if haMultiZoneSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, haMultiZoneSuite)
-	}
 
 	// Private Secure Workspace Suite
-	privateSecureWorkspaceSuite := usage.CreatePrivateSecureWorkspaceV1TestSuite(regionalTestSuite,
+	privateSecureWorkspaceSuite := usage.CreatePrivateSecureWorkspaceV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
 		&usage.PrivateSecureWorkspaceV1Config{
 			NetworkCidr:  config.Parameters.ScenariosCidr,
 			RegionZones:  config.Clients.RegionZones,
@@ -67,7 +59,11 @@ func TestUsageV1Suites(t *testing.T) {
 			NetworkSkus:  config.Clients.NetworkSkus,
 		},
 	)
-	if privateSecureWorkspaceSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, privateSecureWorkspaceSuite)
+
+	return []conformanceSuite{
+		foundationProvidersSuite,
+		multiWorkspaceIsolationSuite,
+		haMultiZoneSuite,
+		privateSecureWorkspaceSuite,
 	}
 }
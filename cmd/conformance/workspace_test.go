@@ -6,29 +6,22 @@ import (
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites/workspace"
-	"github.com/ozontech/allure-go/pkg/framework/suite"
 )
 
 func TestWorkspaceV1Suites(t *testing.T) {
-	regionalTestSuite := suites.CreateRegionalTestSuite(config.Parameters, config.Clients)
+	markParallel(t)
+	runSuites(t, workspaceV1Suites())
+}
 
+func workspaceV1Suites() []conformanceSuite {
 	// Provider LifeCycle Suite
-	providerLifeCycleSuite := workspace.CreateProviderLifeCycleV1TestSuite(regionalTestSuite)
-	if providerLifeCycleSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, providerLifeCycleSuite)
-	}
+	providerLifeCycleSuite := workspace.CreateProviderLifeCycleV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients))
 
 	// Provider Queries Suite
-	providerQueriesSuite := workspace.CreateProviderQueriesV1TestSuite(regionalTestSuite)
-	if providerQueriesSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, providerQueriesSuite)
-	}
+	providerQueriesSuite := workspace.CreateProviderQueriesV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients))
 
 	// Constraints Violations Suite
-	workspaceConstraintsSuite := workspace.CreateWorkspaceConstraintsValidationV1TestSuite(regionalTestSuite)
-	if workspaceConstraintsSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, workspaceConstraintsSuite)
-	}
+	workspaceConstraintsSuite := workspace.CreateWorkspaceConstraintsValidationV1TestSuite(suites.CreateRegionalTestSuite(config.Parameters, config.Clients))
 
 	workspaceErrorSuite := workspace.CreateWorkspaceErrorV1TestSuite(
 		suites.CreateRegionalTestSuite(config.Parameters, config.Clients),
@@ -38,7 +31,11 @@ func TestWorkspaceV1Suites(t *testing.T) {
 			StorageSkus:    config.Clients.StorageSkus,
 		},
 	)
-	if workspaceErrorSuite.CanRun(config.Parameters.ScenariosRegexp) {
-		suite.RunSuite(t, workspaceErrorSuite)
+
+	return []conformanceSuite{
+		providerLifeCycleSuite,
+		providerQueriesSuite,
+		workspaceConstraintsSuite,
+		workspaceErrorSuite,
 	}
 }
@@ -0,0 +1,206 @@
+package attest
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/report"
+)
+
+// Bundle entries
+const (
+	ManifestFile      = "manifest.json"
+	SignatureFile     = "manifest.sig"
+	SummaryFile       = "summary.json"
+	ConfigurationFile = "configuration.json"
+	ResultsDir        = "results"
+
+	manifestVersion = 1
+	goSdkModule     = "github.com/eu-sovereign-cloud/go-sdk"
+)
+
+// Manifest describes the run and lists the digest of every other entry of the bundle
+type Manifest struct {
+	Version         int          `json:"version"`
+	CreatedAt       time.Time    `json:"created_at"`
+	RunID           string       `json:"run_id,omitempty"`
+	Profile         string       `json:"profile,omitempty"`
+	Level           string       `json:"level,omitempty"`
+	BinaryVersion   string       `json:"binary_version"`
+	GoSdkVersion    string       `json:"go_sdk_version"`
+	GoVersion       string       `json:"go_version"`
+	SignerPublicKey string       `json:"signer_public_key,omitempty"`
+	Files           []FileDigest `json:"files"`
+}
+
+type FileDigest struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	Sha256 string `json:"sha256"`
+}
+
+// Evidence is the content of a bundle, besides the versions of the binary and
+// the configuration recorded by the run in its results as ConfigurationFile
+type Evidence struct {
+	ResultsPath string
+	Summary     *report.Summary
+}
+
+type entry struct {
+	path string
+	data []byte
+}
+
+// Create writes the evidence as a gzipped tarball with its manifest, signed when a key is given
+func Create(w io.Writer, evidence Evidence, key ed25519.PrivateKey) (*Manifest, error) {
+	entries, err := evidenceEntries(evidence)
+	if err != nil {
+		return nil, err
+	}
+
+	binaryVersion, goSdkVersion := buildVersions()
+	manifest := &Manifest{
+		Version:       manifestVersion,
+		CreatedAt:     time.Now().UTC(),
+		RunID:         evidence.Summary.RunID,
+		Profile:       evidence.Summary.Profile,
+		Level:         evidence.Summary.Level,
+		BinaryVersion: binaryVersion,
+		GoSdkVersion:  goSdkVersion,
+		GoVersion:     runtime.Version(),
+	}
+	if key != nil {
+		publicKey, _ := key.Public().(ed25519.PublicKey)
+		manifest.SignerPublicKey = base64.StdEncoding.EncodeToString(publicKey)
+	}
+	for _, entry := range entries {
+		digest := sha256.Sum256(entry.data)
+		manifest.Files = append(manifest.Files, FileDigest{
+			Path:   entry.path,
+			Size:   int64(len(entry.data)),
+			Sha256: hex.EncodeToString(digest[:]),
+		})
+	}
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	// The manifest and its signature come first, so they can be read before the evidence
+	head := []entry{{path: ManifestFile, data: manifestData}}
+	if key != nil {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(key, manifestData))
+		head = append(head, entry{path: SignatureFile, data: []byte(signature + "\n")})
+	}
+
+	if err := writeTarball(w, append(head, entries...), manifest.CreatedAt); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func evidenceEntries(evidence Evidence) ([]entry, error) {
+	summaryData, err := json.MarshalIndent(evidence.Summary, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode summary: %w", err)
+	}
+	configurationData, err := os.ReadFile(filepath.Join(evidence.ResultsPath, ConfigurationFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the configuration recorded by the run: %w", err)
+	}
+	entries := []entry{
+		{path: SummaryFile, data: summaryData},
+		{path: ConfigurationFile, data: configurationData},
+	}
+
+	err = filepath.WalkDir(evidence.ResultsPath, func(filePath string, dirEntry fs.DirEntry, err error) error {
+		if err != nil || dirEntry.IsDir() {
+			return err
+		}
+		relative, err := filepath.Rel(evidence.ResultsPath, filePath)
+		if err != nil {
+			return err
+		}
+		// Already the configuration entry of the bundle
+		if relative == ConfigurationFile {
+			return nil
+		}
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry{path: path.Join(ResultsDir, filepath.ToSlash(relative)), data: data})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read results: %w", err)
+	}
+	return entries, nil
+}
+
+func writeTarball(w io.Writer, entries []entry, modTime time.Time) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, entry := range entries {
+		header := &tar.Header{
+			Name:    entry.path,
+			Mode:    0o644,
+			Size:    int64(len(entry.data)),
+			ModTime: modTime,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return fmt.Errorf("failed to write bundle entry %s: %w", entry.path, err)
+		}
+		if _, err := io.Copy(tarWriter, bytes.NewReader(entry.data)); err != nil {
+			return fmt.Errorf("failed to write bundle entry %s: %w", entry.path, err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// buildVersions returns the version of the binary, with its revision when known, and of the go-sdk module
+func buildVersions() (string, string) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown", "unknown"
+	}
+
+	binaryVersion := info.Main.Version
+	if binaryVersion == "" {
+		binaryVersion = "(devel)"
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			binaryVersion += " " + setting.Value
+		}
+	}
+
+	goSdkVersion := "unknown"
+	for _, dep := range info.Deps {
+		if dep.Path == goSdkModule {
+			goSdkVersion = dep.Version
+			if dep.Replace != nil {
+				goSdkVersion += " => " + dep.Replace.Path + " " + dep.Replace.Version
+			}
+		}
+	}
+	return binaryVersion, goSdkVersion
+}
@@ -0,0 +1,133 @@
+package attest
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Verification reports how the signature of a bundle was checked
+type Verification struct {
+	Manifest *Manifest
+	Signed   bool
+	// Trusted is set when the signature was checked with the given public key, rather than the one in the manifest
+	Trusted bool
+}
+
+// Verify checks offline that every entry of the bundle matches its manifest digest,
+// and that the manifest signature is valid, against the public key when one is given
+func Verify(r io.Reader, publicKey ed25519.PublicKey) (*Verification, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bundle: %w", err)
+	}
+	defer gzipReader.Close()
+
+	var manifestData, signatureData []byte
+	digests := map[string]FileDigest{}
+	seen := map[string]bool{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid bundle: %w", err)
+		}
+		// Directories carry no content, e.g. when the bundle was repacked
+		if header.Typeflag == tar.TypeDir {
+			continue
+		}
+		// A repeated entry would hide the one checked against the manifest behind another one
+		if seen[header.Name] {
+			return nil, fmt.Errorf("invalid bundle: entry %s is repeated", header.Name)
+		}
+		seen[header.Name] = true
+
+		switch header.Name {
+		case ManifestFile:
+			manifestData, err = io.ReadAll(tarReader)
+		case SignatureFile:
+			signatureData, err = io.ReadAll(tarReader)
+		default:
+			hash := sha256.New()
+			var size int64
+			size, err = io.Copy(hash, tarReader)
+			digests[header.Name] = FileDigest{Path: header.Name, Size: size, Sha256: hex.EncodeToString(hash.Sum(nil))}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bundle entry %s: %w", header.Name, err)
+		}
+	}
+	if manifestData == nil {
+		return nil, fmt.Errorf("invalid bundle: %s is missing", ManifestFile)
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(manifestData, manifest); err != nil {
+		return nil, fmt.Errorf("invalid bundle %s: %w", ManifestFile, err)
+	}
+
+	var errs []error
+	for _, expected := range manifest.Files {
+		actual, found := digests[expected.Path]
+		switch {
+		case !found:
+			errs = append(errs, fmt.Errorf("%s is missing", expected.Path))
+		case actual != expected:
+			errs = append(errs, fmt.Errorf("%s does not match its digest", expected.Path))
+		}
+		delete(digests, expected.Path)
+	}
+	for name := range digests {
+		errs = append(errs, fmt.Errorf("%s is not listed in the manifest", name))
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("bundle integrity check failed: %w", errors.Join(errs...))
+	}
+
+	verification := &Verification{Manifest: manifest}
+	if err := verifySignature(verification, manifestData, signatureData, publicKey); err != nil {
+		return nil, err
+	}
+	return verification, nil
+}
+
+func verifySignature(verification *Verification, manifestData []byte, signatureData []byte, publicKey ed25519.PublicKey) error {
+	if signatureData == nil {
+		if publicKey != nil {
+			return fmt.Errorf("bundle signature check failed: %s is missing", SignatureFile)
+		}
+		return nil
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signatureData)))
+	if err != nil {
+		return fmt.Errorf("invalid bundle %s: %w", SignatureFile, err)
+	}
+
+	// Without a trusted key, the signature can only be checked against the key of the manifest
+	verification.Trusted = publicKey != nil
+	if publicKey == nil {
+		embedded, err := base64.StdEncoding.DecodeString(verification.Manifest.SignerPublicKey)
+		if err != nil || len(embedded) != ed25519.PublicKeySize {
+			return errors.New("bundle signature check failed: the manifest has no valid signer public key")
+		}
+		publicKey = embedded
+	}
+
+	if !ed25519.Verify(publicKey, manifestData, signature) {
+		return errors.New("bundle signature check failed: the manifest signature does not match the public key")
+	}
+	verification.Signed = true
+	return nil
+}
@@ -0,0 +1,191 @@
+package attest
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/report"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const resultFile = ResultsDir + "/result.json"
+
+func TestVerifyRoundTrip(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	publicKey, _ := key.Public().(ed25519.PublicKey)
+
+	tests := []struct {
+		name        string
+		key         ed25519.PrivateKey
+		publicKey   ed25519.PublicKey
+		wantSigned  bool
+		wantTrusted bool
+	}{
+		{name: "unsigned"},
+		{name: "signed", key: key, wantSigned: true},
+		{name: "signed with public key", key: key, publicKey: publicKey, wantSigned: true, wantTrusted: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bundle, manifest := createBundle(t, test.key)
+
+			verification, err := Verify(bytes.NewReader(bundle), test.publicKey)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantSigned, verification.Signed)
+			assert.Equal(t, test.wantTrusted, verification.Trusted)
+			assert.Equal(t, "run-1", verification.Manifest.RunID)
+			assert.Equal(t, manifest.Files, verification.Manifest.Files)
+		})
+	}
+}
+
+func TestVerifyRejectsBundle(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherPublicKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		key       ed25519.PrivateKey
+		publicKey ed25519.PublicKey
+		repack    func([]entry) []entry
+		wantErr   string
+	}{
+		{
+			name: "entry changed after packing",
+			repack: func(entries []entry) []entry {
+				return replaceEntry(entries, resultFile, []byte(`{"status":"passed"}`))
+			},
+			wantErr: resultFile + " does not match its digest",
+		},
+		{
+			name: "entry missing",
+			repack: func(entries []entry) []entry {
+				return removeEntry(entries, resultFile)
+			},
+			wantErr: resultFile + " is missing",
+		},
+		{
+			name: "entry not listed in the manifest",
+			repack: func(entries []entry) []entry {
+				return append(entries, entry{path: ResultsDir + "/extra.json", data: []byte("{}")})
+			},
+			wantErr: ResultsDir + "/extra.json is not listed in the manifest",
+		},
+		{
+			name: "entry repeated",
+			repack: func(entries []entry) []entry {
+				return append(entries, entry{path: resultFile, data: []byte(`{"status":"passed"}`)})
+			},
+			wantErr: "entry " + resultFile + " is repeated",
+		},
+		{
+			name: "manifest repeated",
+			repack: func(entries []entry) []entry {
+				return append(entries, entry{path: ManifestFile, data: []byte(`{"files":[]}`)})
+			},
+			wantErr: "entry " + ManifestFile + " is repeated",
+		},
+		{
+			name: "signature repeated",
+			key:  key,
+			repack: func(entries []entry) []entry {
+				return append(entries, entry{path: SignatureFile, data: []byte("c2lnbmF0dXJl\n")})
+			},
+			wantErr: "entry " + SignatureFile + " is repeated",
+		},
+		{
+			name:      "signature checked with the wrong public key",
+			key:       key,
+			publicKey: otherPublicKey,
+			wantErr:   "the manifest signature does not match the public key",
+		},
+		{
+			name:      "signature missing for the public key",
+			publicKey: otherPublicKey,
+			wantErr:   SignatureFile + " is missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bundle, _ := createBundle(t, test.key)
+			if test.repack != nil {
+				bundle = repackBundle(t, bundle, test.repack)
+			}
+
+			_, err := Verify(bytes.NewReader(bundle), test.publicKey)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.wantErr)
+		})
+	}
+}
+
+func createBundle(t *testing.T, key ed25519.PrivateKey) ([]byte, *Manifest) {
+	t.Helper()
+
+	resultsPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(resultsPath, ConfigurationFile), []byte(`{"level":"core"}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(resultsPath, "result.json"), []byte(`{"status":"failed"}`), 0o600))
+
+	var bundle bytes.Buffer
+	manifest, err := Create(&bundle, Evidence{ResultsPath: resultsPath, Summary: &report.Summary{RunID: "run-1"}}, key)
+	require.NoError(t, err)
+	return bundle.Bytes(), manifest
+}
+
+// repackBundle rewrites the entries of the bundle as changed, keeping its manifest
+func repackBundle(t *testing.T, bundle []byte, change func([]entry) []entry) []byte {
+	t.Helper()
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(bundle))
+	require.NoError(t, err)
+	tarReader := tar.NewReader(gzipReader)
+
+	var entries []entry
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		entries = append(entries, entry{path: header.Name, data: data})
+	}
+
+	var repacked bytes.Buffer
+	require.NoError(t, writeTarball(&repacked, change(entries), time.Now()))
+	return repacked.Bytes()
+}
+
+func replaceEntry(entries []entry, path string, data []byte) []entry {
+	for i := range entries {
+		if entries[i].path == path {
+			entries[i].data = data
+		}
+	}
+	return entries
+}
+
+func removeEntry(entries []entry, path string) []entry {
+	kept := entries[:0]
+	for _, entry := range entries {
+		if entry.path != path {
+			kept = append(kept, entry)
+		}
+	}
+	return kept
+}
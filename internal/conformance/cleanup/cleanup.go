@@ -0,0 +1,360 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/steps"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/types"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
+)
+
+// DefaultOlderThan is longer than a normal run, so the resources of a run still in progress are kept
+const DefaultOlderThan = 24 * time.Hour
+
+type Options struct {
+	Tenant    string
+	OlderThan time.Duration
+	RunID     string
+}
+
+type Candidate struct {
+	Entry     suites.LedgerEntry
+	CreatedAt time.Time
+}
+
+type Plan struct {
+	Candidates []Candidate
+}
+
+// Sweep
+
+type sweeper struct {
+	options    Options
+	now        time.Time
+	candidates []Candidate
+}
+
+type sweepParams[R types.ResourceType] struct {
+	list     func(context.Context, *secapi.ListOptions) (*secapi.Iterator[R], error)
+	describe func(*R) (schema.Labels, time.Time)
+	entry    func(*R) suites.LedgerEntry
+}
+
+// BuildPlan lists every resource labeled as created by the conformance tests, in deletion order
+func BuildPlan(ctx context.Context, global *secapi.GlobalClient, regional *secapi.RegionalClient, options Options) (*Plan, error) {
+	s := &sweeper{options: options, now: time.Now()}
+	tpath := secapi.TenantPath{Tenant: secapi.TenantID(options.Tenant)}
+
+	authorization := global.AuthorizationV1
+	if _, err := sweep(ctx, s, sweepParams[schema.Role]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.Role], error) {
+			return authorization.ListRolesWithOptions(ctx, tpath, options)
+		},
+		describe: func(resource *schema.Role) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.Role) suites.LedgerEntry {
+			return steps.RoleV1LedgerEntry(authorization, resource)
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("listing roles: %w", err)
+	}
+	if _, err := sweep(ctx, s, sweepParams[schema.RoleAssignment]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.RoleAssignment], error) {
+			return authorization.ListRoleAssignmentsWithOptions(ctx, tpath, options)
+		},
+		describe: func(resource *schema.RoleAssignment) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.RoleAssignment) suites.LedgerEntry {
+			return steps.RoleAssignmentV1LedgerEntry(authorization, resource)
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("listing role assignments: %w", err)
+	}
+
+	storage := regional.StorageV1
+	if _, err := sweep(ctx, s, sweepParams[schema.Image]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.Image], error) {
+			return storage.ListImagesWithOptions(ctx, tpath, options)
+		},
+		describe: func(resource *schema.Image) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.Image) suites.LedgerEntry {
+			return steps.ImageV1LedgerEntry(storage, resource)
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("listing images: %w", err)
+	}
+
+	workspace := regional.WorkspaceV1
+	workspaces, err := sweep(ctx, s, sweepParams[schema.Workspace]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.Workspace], error) {
+			return workspace.ListWorkspacesWithOptions(ctx, tpath, options)
+		},
+		describe: func(resource *schema.Workspace) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.Workspace) suites.LedgerEntry {
+			return steps.WorkspaceV1LedgerEntry(workspace, resource)
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing workspaces: %w", err)
+	}
+
+	// Workspaces younger than the threshold are kept, but may still hold older resources
+	for _, ws := range workspaces {
+		wpath := secapi.WorkspacePath{Tenant: tpath.Tenant, Workspace: secapi.WorkspaceID(ws.Metadata.Name)}
+		if err := sweepWorkspace(ctx, s, regional, wpath); err != nil {
+			return nil, fmt.Errorf("sweeping workspace %s: %w", ws.Metadata.Name, err)
+		}
+	}
+
+	slices.SortStableFunc(s.candidates, func(a, b Candidate) int {
+		return constants.ResourceDeletionRank(a.Entry.Kind) - constants.ResourceDeletionRank(b.Entry.Kind)
+	})
+	return &Plan{Candidates: s.candidates}, nil
+}
+
+func sweepWorkspace(ctx context.Context, s *sweeper, regional *secapi.RegionalClient, wpath secapi.WorkspacePath) error {
+	storage := regional.StorageV1
+	if _, err := sweep(ctx, s, sweepParams[schema.BlockStorage]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.BlockStorage], error) {
+			return storage.ListBlockStoragesWithOptions(ctx, wpath, options)
+		},
+		describe: func(resource *schema.BlockStorage) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.BlockStorage) suites.LedgerEntry {
+			return steps.BlockStorageV1LedgerEntry(storage, resource)
+		},
+	}); err != nil {
+		return fmt.Errorf("listing block storages: %w", err)
+	}
+
+	compute := regional.ComputeV1
+	if _, err := sweep(ctx, s, sweepParams[schema.Instance]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.Instance], error) {
+			return compute.ListInstancesWithOptions(ctx, wpath, options)
+		},
+		describe: func(resource *schema.Instance) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.Instance) suites.LedgerEntry {
+			return steps.InstanceV1LedgerEntry(compute, resource)
+		},
+	}); err != nil {
+		return fmt.Errorf("listing instances: %w", err)
+	}
+
+	network := regional.NetworkV1
+	networks, err := sweep(ctx, s, sweepParams[schema.Network]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.Network], error) {
+			return network.ListNetworksWithOptions(ctx, wpath, options)
+		},
+		describe: func(resource *schema.Network) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.Network) suites.LedgerEntry {
+			return steps.NetworkV1LedgerEntry(network, resource)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("listing networks: %w", err)
+	}
+	for _, net := range networks {
+		npath := secapi.NetworkPath{Tenant: wpath.Tenant, Workspace: wpath.Workspace, Network: secapi.NetworkID(net.Metadata.Name)}
+		if err := sweepNetwork(ctx, s, network, npath); err != nil {
+			return fmt.Errorf("sweeping network %s: %w", net.Metadata.Name, err)
+		}
+	}
+
+	if _, err := sweep(ctx, s, sweepParams[schema.InternetGateway]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.InternetGateway], error) {
+			return network.ListInternetGatewaysWithOptions(ctx, wpath, options)
+		},
+		describe: func(resource *schema.InternetGateway) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.InternetGateway) suites.LedgerEntry {
+			return steps.InternetGatewayV1LedgerEntry(network, resource)
+		},
+	}); err != nil {
+		return fmt.Errorf("listing internet gateways: %w", err)
+	}
+	if _, err := sweep(ctx, s, sweepParams[schema.SecurityGroup]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.SecurityGroup], error) {
+			return network.ListSecurityGroupsWithOptions(ctx, wpath, options)
+		},
+		describe: func(resource *schema.SecurityGroup) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.SecurityGroup) suites.LedgerEntry {
+			return steps.SecurityGroupV1LedgerEntry(network, resource)
+		},
+	}); err != nil {
+		return fmt.Errorf("listing security groups: %w", err)
+	}
+	if _, err := sweep(ctx, s, sweepParams[schema.SecurityGroupRule]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.SecurityGroupRule], error) {
+			return network.ListSecurityGroupRulesWithOptions(ctx, wpath, options)
+		},
+		describe: func(resource *schema.SecurityGroupRule) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.SecurityGroupRule) suites.LedgerEntry {
+			return steps.SecurityGroupRuleV1LedgerEntry(network, resource)
+		},
+	}); err != nil {
+		return fmt.Errorf("listing security group rules: %w", err)
+	}
+	if _, err := sweep(ctx, s, sweepParams[schema.Nic]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.Nic], error) {
+			return network.ListNicsWithOptions(ctx, wpath, options)
+		},
+		describe: func(resource *schema.Nic) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.Nic) suites.LedgerEntry {
+			return steps.NicV1LedgerEntry(network, resource)
+		},
+	}); err != nil {
+		return fmt.Errorf("listing nics: %w", err)
+	}
+	if _, err := sweep(ctx, s, sweepParams[schema.PublicIp]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.PublicIp], error) {
+			return network.ListPublicIpsWithOptions(ctx, wpath, options)
+		},
+		describe: func(resource *schema.PublicIp) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.PublicIp) suites.LedgerEntry {
+			return steps.PublicIpV1LedgerEntry(network, resource)
+		},
+	}); err != nil {
+		return fmt.Errorf("listing public ips: %w", err)
+	}
+
+	return nil
+}
+
+func sweepNetwork(ctx context.Context, s *sweeper, network secapi.NetworkV1, npath secapi.NetworkPath) error {
+	if _, err := sweep(ctx, s, sweepParams[schema.Subnet]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.Subnet], error) {
+			return network.ListSubnetsWithOptions(ctx, npath, options)
+		},
+		describe: func(resource *schema.Subnet) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.Subnet) suites.LedgerEntry {
+			return steps.SubnetV1LedgerEntry(network, resource)
+		},
+	}); err != nil {
+		return fmt.Errorf("listing subnets: %w", err)
+	}
+	if _, err := sweep(ctx, s, sweepParams[schema.RouteTable]{
+		list: func(ctx context.Context, options *secapi.ListOptions) (*secapi.Iterator[schema.RouteTable], error) {
+			return network.ListRouteTablesWithOptions(ctx, npath, options)
+		},
+		describe: func(resource *schema.RouteTable) (schema.Labels, time.Time) {
+			return resource.Labels, resource.Metadata.CreatedAt
+		},
+		entry: func(resource *schema.RouteTable) suites.LedgerEntry {
+			return steps.RouteTableV1LedgerEntry(network, resource)
+		},
+	}); err != nil {
+		return fmt.Errorf("listing route tables: %w", err)
+	}
+
+	return nil
+}
+
+// sweep returns every labeled resource found, and plans the ones older than the threshold for deletion
+func sweep[R types.ResourceType](ctx context.Context, s *sweeper, params sweepParams[R]) ([]*R, error) {
+	// The run ID label is stamped on every built resource, whatever its other labels
+	selector := builders.NewLabelsBuilder().Equals(constants.ManagedByLabel, constants.ManagedBySecatestLabel)
+	if s.options.RunID != "" {
+		selector = builders.NewLabelsBuilder().Equals(constants.RunIDLabel, s.options.RunID)
+	}
+	options := secapi.NewListOptions().WithLabels(selector)
+
+	iter, err := params.list(ctx, options)
+	if errors.Is(err, secapi.ErrProviderNotAvailable) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	resources, err := iter.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var labeled []*R
+	for _, resource := range resources {
+		// Double check the label, a provider ignoring the selector must not cause foreign resources to be deleted
+		labels, createdAt := params.describe(resource)
+		if s.options.RunID == "" && labels[constants.ManagedByLabel] != constants.ManagedBySecatestLabel {
+			continue
+		}
+		if s.options.RunID != "" && labels[constants.RunIDLabel] != s.options.RunID {
+			continue
+		}
+		labeled = append(labeled, resource)
+
+		if s.now.Sub(createdAt) < s.options.OlderThan {
+			continue
+		}
+		s.candidates = append(s.candidates, Candidate{Entry: params.entry(resource), CreatedAt: createdAt})
+	}
+	return labeled, nil
+}
+
+// Output
+
+func WritePlan(w io.Writer, plan *Plan) error {
+	if len(plan.Candidates) == 0 {
+		_, err := fmt.Fprintln(w, "No leftover conformance resources found")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tREFERENCE\tCREATED")
+	for _, candidate := range plan.Candidates {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", candidate.Entry.Kind, candidate.Entry.Reference, candidate.CreatedAt.Format(time.RFC3339))
+	}
+	fmt.Fprintf(tw, "\n%d resources planned for deletion\n", len(plan.Candidates))
+	return tw.Flush()
+}
+
+// Execution
+
+// ExecutePlan deletes every candidate and waits for it to disappear, carrying on past failures
+func ExecutePlan(ctx context.Context, w io.Writer, plan *Plan, config secapi.ResourceObserverConfig) error {
+	var errs []error
+	for _, candidate := range plan.Candidates {
+		fmt.Fprintf(w, "Deleting %s %s\n", candidate.Entry.Kind, candidate.Entry.Reference)
+
+		err := candidate.Entry.DeleteFunc(ctx)
+		if err == nil {
+			err = candidate.Entry.WatchFunc(ctx, config)
+		}
+		if err != nil {
+			fmt.Fprintf(w, "Failed to delete %s: %v\n", candidate.Entry.Reference, err)
+			errs = append(errs, fmt.Errorf("deleting %s: %w", candidate.Entry.Reference, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AssertionMode sets whether a verify step stops the scenario at its first mismatching field,
+// or records them all as a diff and fails the scenario at the end of the step
+type AssertionMode string
+
+const (
+	StrictAssertions AssertionMode = "strict"
+	SoftAssertions   AssertionMode = "soft"
+)
+
+// ParseAssertionMode returns the mode matching the name, ignoring case
+func ParseAssertionMode(name string) (AssertionMode, error) {
+	switch mode := AssertionMode(strings.ToLower(name)); mode {
+	case StrictAssertions, SoftAssertions:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown assertion mode %q, must be one of strict, soft", name)
+	}
+}
@@ -3,20 +3,31 @@ package config
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"sync"
 
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/openapi"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/params"
 	"github.com/eu-sovereign-cloud/conformance/internal/constants"
 	"github.com/eu-sovereign-cloud/conformance/internal/mock"
 	mockscenarios "github.com/eu-sovereign-cloud/conformance/internal/mock/scenarios"
 	mockclients "github.com/eu-sovereign-cloud/conformance/internal/mock/scenarios/clients"
+	sdkconsts "github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
 	"github.com/eu-sovereign-cloud/go-sdk/secapi"
 )
 
+// ClientsHolder is read-only once initialized, so concurrent suites may share it
 type ClientsHolder struct {
 	GlobalClient   *secapi.GlobalClient
 	RegionalClient *secapi.RegionalClient
 
+	Providers []string
+
+	// Responses validates the responses of the providers, nil when disabled
+	Responses *openapi.Validator
+
 	RegionZones  []string
 	InstanceSkus []string
 	StorageSkus  []string
@@ -55,6 +66,10 @@ func InitClients(ctx context.Context) error {
 		}
 	}
 
+	// Record the exchanges of the clients, the generated go-sdk clients sending them through the default transport.
+	// Always installed, since the rejected steps read the status and body of the error responses from it
+	har.Install()
+
 	var err error
 	Clients = &ClientsHolder{}
 
@@ -76,6 +91,9 @@ func InitClients(ctx context.Context) error {
 		return fmt.Errorf("failed to create regional client: %w", err)
 	}
 
+	// Detect the providers available in the region
+	Clients.Providers = availableProviders(Clients.GlobalClient, Clients.RegionalClient)
+
 	// Load region available zones
 	regionResp, err := Clients.GlobalClient.RegionV1.GetRegion(ctx, Parameters.ClientRegion)
 	if err != nil {
@@ -83,6 +101,21 @@ func InitClients(ctx context.Context) error {
 	}
 	Clients.RegionZones = regionResp.Spec.AvailableZones
 
+	// Load the OpenAPI documents of the providers, if configured to validate the responses
+	if Parameters.ValidateResponses {
+		providers := map[string]string{
+			sdkconsts.RegionProviderV1Name:        Parameters.ProviderRegionV1,
+			sdkconsts.AuthorizationProviderV1Name: Parameters.ProviderAuthorizationV1,
+		}
+		for _, provider := range regionResp.Spec.Providers {
+			providers[provider.Name+"/"+provider.Version] = provider.Url
+		}
+		Clients.Responses, err = openapi.NewValidator(providers)
+		if err != nil {
+			return fmt.Errorf("failed to load the OpenAPI documents: %w", err)
+		}
+	}
+
 	// Load available instance skus, if compute provider is available
 	_, isComputeV1Unavailable := Clients.RegionalClient.ComputeV1.(*secapi.ComputeV1Unavailable)
 	if !isComputeV1Unavailable {
@@ -120,6 +153,30 @@ func InitClients(ctx context.Context) error {
 	return nil
 }
 
+func availableProviders(globalClient *secapi.GlobalClient, regionalClient *secapi.RegionalClient) []string {
+	unavailable := map[string]bool{
+		sdkconsts.RegionProviderV1Name:        isUnavailable[*secapi.RegionV1Unavailable](globalClient.RegionV1),
+		sdkconsts.AuthorizationProviderV1Name: isUnavailable[*secapi.AuthorizationV1Unavailable](globalClient.AuthorizationV1),
+		sdkconsts.WorkspaceProviderV1Name:     isUnavailable[*secapi.WorkspaceV1Unavailable](regionalClient.WorkspaceV1),
+		sdkconsts.ComputeProviderV1Name:       isUnavailable[*secapi.ComputeV1Unavailable](regionalClient.ComputeV1),
+		sdkconsts.StorageProviderV1Name:       isUnavailable[*secapi.StorageV1Unavailable](regionalClient.StorageV1),
+		sdkconsts.NetworkProviderV1Name:       isUnavailable[*secapi.NetworkV1Unavailable](regionalClient.NetworkV1),
+	}
+
+	var available []string
+	for _, name := range slices.Sorted(maps.Keys(unavailable)) {
+		if !unavailable[name] {
+			available = append(available, name)
+		}
+	}
+	return available
+}
+
+func isUnavailable[U any](api any) bool {
+	_, ok := api.(U)
+	return ok
+}
+
 // TODO Convert these load skus functions to a generic one
 func loadInstanceSkus(ctx context.Context, regionalClient *secapi.RegionalClient) ([]string, error) {
 	resp, err := regionalClient.ComputeV1.ListSkus(ctx, secapi.TenantPath{Tenant: secapi.TenantID(Parameters.ClientTenant)})
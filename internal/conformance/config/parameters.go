@@ -1,12 +1,29 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"regexp"
+	"slices"
+	"strings"
 	"sync"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/progress"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/selector"
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+	"github.com/eu-sovereign-cloud/conformance/internal/logging"
+	"github.com/eu-sovereign-cloud/conformance/internal/report"
+	"github.com/eu-sovereign-cloud/conformance/internal/slo"
+	"github.com/eu-sovereign-cloud/conformance/internal/telemetry"
 )
 
+// ParametersHolder is read-only once the run starts, so concurrent suites may share it
 type ParametersHolder struct {
+	ConfigFile string
+	Profile    string
+
 	ProviderRegionV1        string
 	ProviderAuthorizationV1 string
 
@@ -14,17 +31,45 @@ type ParametersHolder struct {
 	ClientRegion    string
 	ClientTenant    string
 
-	ScenariosFilter string
-	ScenariosRegexp *regexp.Regexp
+	ScenariosFilter   string
+	ScenariosSelect   []string
+	ScenariosExclude  []string
+	ScenariosSelector *selector.Selector
+
+	Level            string
+	ConformanceLevel constants.ConformanceLevel
 
 	ScenariosAdditionalRegions []string
 	ScenariosUsers             []string
 	ScenariosCidr              string
 	ScenariosPublicIps         string
 
-	ReportResultsPath string
-	SummaryOutputPath string
-	SummaryFormat     string
+	RunID    string
+	Parallel int
+	Progress string
+
+	Assertions    string
+	AssertionMode AssertionMode
+
+	SloThresholds []string
+	Thresholds    []slo.Threshold
+	SloBlocking   bool
+
+	LogLevel  string
+	LogFormat string
+	LogFile   string
+
+	OtlpEndpoint string
+	OtlpHeaders  []string
+	OtlpFile     string
+
+	ReportResultsPath      string
+	ReportHar              bool
+	ValidateResponses      bool
+	SummaryOutputPath      string
+	SummaryJUnitOutputPath string
+	SummaryFormat          string
+	SummaryParameters      string
 
 	BaseDelay    int
 	BaseInterval int
@@ -35,9 +80,24 @@ type ParametersHolder struct {
 	MockProviders []string
 }
 
+// Parameters a command can require
+const (
+	ProviderRegionV1Parameter  = "provider.region.v1"
+	ClientAuthTokenParameter   = "client.auth.token"
+	ClientRegionParameter      = "client.region"
+	ClientTenantParameter      = "client.tenant"
+	ReportResultsPathParameter = "report.results.path"
+	OtlpHeadersParameter       = "otlp.headers"
+)
+
+// ClientParameters are required to connect to the providers
+var ClientParameters = []string{ProviderRegionV1Parameter, ClientAuthTokenParameter, ClientRegionParameter, ClientTenantParameter}
+
 var (
 	Parameters     *ParametersHolder
 	parametersLock sync.Mutex
+
+	runIDRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
 )
 
 func InitParameters() {
@@ -51,17 +111,149 @@ func InitParameters() {
 	Parameters = &ParametersHolder{}
 }
 
-func ProcessParameters() error {
+// ProcessParameters validates the loaded parameters, reporting every missing or malformed value at once
+func ProcessParameters(required ...string) error {
 	parametersLock.Lock()
 	defer parametersLock.Unlock()
 
-	if Parameters.ScenariosFilter != "" {
-		expr, err := regexp.Compile(Parameters.ScenariosFilter)
+	var errs []error
+
+	// Required values
+	values := map[string]string{
+		ProviderRegionV1Parameter:  Parameters.ProviderRegionV1,
+		ClientAuthTokenParameter:   Parameters.ClientAuthToken,
+		ClientRegionParameter:      Parameters.ClientRegion,
+		ClientTenantParameter:      Parameters.ClientTenant,
+		ReportResultsPathParameter: Parameters.ReportResultsPath,
+	}
+	for _, name := range required {
+		if values[name] == "" {
+			errs = append(errs, fmt.Errorf("missing %s: set the --%s flag, the %s env variable or the config file key", name, name, EnvName(name)))
+		}
+	}
+
+	// Provider endpoints
+	errs = append(errs, validateURL("provider.region.v1", Parameters.ProviderRegionV1))
+	errs = append(errs, validateURL("provider.authorization.v1", Parameters.ProviderAuthorizationV1))
+
+	// Scenario inputs
+	scenariosSelector, err := selector.Parse(Parameters.ScenariosFilter, Parameters.ScenariosSelect, Parameters.ScenariosExclude)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	Parameters.ScenariosSelector = scenariosSelector
+	if Parameters.Level != "" {
+		level, err := constants.ParseConformanceLevel(Parameters.Level)
 		if err != nil {
-			return fmt.Errorf("invalid scenarios.filter expression: %w", err)
+			errs = append(errs, fmt.Errorf("invalid level: %w", err))
+		}
+		Parameters.ConformanceLevel = level
+	}
+	errs = append(errs, validateCIDR("scenarios.cidr", Parameters.ScenariosCidr))
+	errs = append(errs, validateCIDR("scenarios.public.ips", Parameters.ScenariosPublicIps))
+	for _, region := range Parameters.ScenariosAdditionalRegions {
+		if strings.TrimSpace(region) == "" {
+			errs = append(errs, errors.New("invalid scenarios.additional.regions: region names must not be empty"))
+		}
+	}
+	for _, user := range Parameters.ScenariosUsers {
+		if strings.TrimSpace(user) == "" {
+			errs = append(errs, errors.New("invalid scenarios.users: user names must not be empty"))
+		}
+	}
+
+	// Run settings
+	if Parameters.Parallel < 0 {
+		errs = append(errs, fmt.Errorf("invalid parallel value %d: must be a positive number of suites", Parameters.Parallel))
+	}
+	if Parameters.RunID != "" && !runIDRegexp.MatchString(Parameters.RunID) {
+		errs = append(errs, fmt.Errorf("invalid run.id %q: must be a lowercase kebab-case label value of at most 63 characters", Parameters.RunID))
+	}
+
+	if Parameters.Assertions != "" {
+		assertionMode, err := ParseAssertionMode(Parameters.Assertions)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid assertions: %w", err))
+		}
+		Parameters.AssertionMode = assertionMode
+	}
+
+	thresholds, err := slo.ParseThresholds(Parameters.SloThresholds)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("invalid slo.thresholds: %w", err))
+	}
+	Parameters.Thresholds = thresholds
+
+	if Parameters.Progress != "" {
+		if _, err := progress.ParseMode(Parameters.Progress); err != nil {
+			errs = append(errs, fmt.Errorf("invalid progress: %w", err))
+		}
+	}
+
+	if Parameters.SummaryFormat != "" && !slices.Contains([]string{"json", "text", "junit"}, Parameters.SummaryFormat) {
+		errs = append(errs, fmt.Errorf("invalid summary: unknown summary format %q, must be one of json, text, junit", Parameters.SummaryFormat))
+	}
+	if Parameters.SummaryParameters != "" {
+		if _, err := report.ParseParametersMode(Parameters.SummaryParameters); err != nil {
+			errs = append(errs, fmt.Errorf("invalid summary.parameters: %w", err))
 		}
-		Parameters.ScenariosRegexp = expr
 	}
 
+	// Log settings
+	if _, err := logging.ParseLevel(Parameters.LogLevel); err != nil {
+		errs = append(errs, fmt.Errorf("invalid log.level: %w", err))
+	}
+	if _, err := logging.ParseFormat(Parameters.LogFormat); err != nil {
+		errs = append(errs, fmt.Errorf("invalid log.format: %w", err))
+	}
+
+	// Trace export settings
+	errs = append(errs, validateURL("otlp.endpoint", Parameters.OtlpEndpoint))
+	if _, err := telemetry.ParseHeaders(Parameters.OtlpHeaders); err != nil {
+		errs = append(errs, fmt.Errorf("invalid otlp.headers: %w", err))
+	}
+
+	// Retry settings
+	if Parameters.BaseDelay < 0 {
+		errs = append(errs, fmt.Errorf("invalid retry.base.delay value %d: must not be negative", Parameters.BaseDelay))
+	}
+	if Parameters.BaseInterval < 0 {
+		errs = append(errs, fmt.Errorf("invalid retry.base.interval value %d: must not be negative", Parameters.BaseInterval))
+	}
+	if Parameters.MaxAttempts <= 0 {
+		errs = append(errs, fmt.Errorf("invalid retry.max.attempts value %d: must be a positive number of attempts", Parameters.MaxAttempts))
+	}
+
+	// Mock settings
+	if Parameters.MockEnabled {
+		if Parameters.MockServerURL == "" {
+			errs = append(errs, errors.New("missing mock.server.url: required when mock.enabled is set"))
+		}
+		errs = append(errs, validateURL("mock.server.url", Parameters.MockServerURL))
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateURL(name string, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid %s %q: must be an absolute http or https URL", name, value)
+	}
+	return nil
+}
+
+func validateCIDR(name string, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		return fmt.Errorf("invalid %s %q: must be in CIDR format", name, value)
+	}
 	return nil
 }
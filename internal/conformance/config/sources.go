@@ -0,0 +1,224 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ConfigFlag names the flag pointing to the configuration file, which is never read from the file itself
+	ConfigFlag = "config"
+
+	// ProfileFlag names the flag selecting a profile of the configuration file, which may default it
+	ProfileFlag = "profile"
+
+	profilesKey = "profiles"
+
+	// envPrefix is prepended to the variable of every flag, not to collide with unrelated ones
+	envPrefix = "SECATEST_"
+
+	// helpFlag is added by cobra, it is never loaded from the sources
+	helpFlag = "help"
+
+	redactedValue = "<redacted>"
+)
+
+// secretParameters are never recorded with their value
+var secretParameters = []string{ClientAuthTokenParameter, OtlpHeadersParameter}
+
+// EnvName returns the environment variable bound to the flag, e.g. SECATEST_PROVIDER_REGION_V1 for provider.region.v1
+func EnvName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(flagName))
+}
+
+// LoadSources fills the flags not set on the command line, taking the environment
+// variable first, then the selected profile and then the configuration file, if any
+func LoadSources(flags *pflag.FlagSet) error {
+	path := sourceValue(flags, ConfigFlag, nil)
+
+	var document map[string]any
+	if path != "" {
+		var err error
+		document, err = readConfigFile(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	values, err := resolveProfile(document, sourceValue(flags, ProfileFlag, document))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	flags.VisitAll(func(flag *pflag.Flag) {
+		if flag.Changed || flag.Name == ConfigFlag || flag.Name == helpFlag {
+			return
+		}
+
+		if value, found := os.LookupEnv(EnvName(flag.Name)); found {
+			if err := flags.Set(flag.Name, value); err != nil {
+				errs = append(errs, fmt.Errorf("invalid env %s value %q: %w", EnvName(flag.Name), value, err))
+			}
+			return
+		}
+
+		items, found := values[flag.Name]
+		if !found {
+			return
+		}
+
+		// File lists keep their items whole, even when they contain commas
+		var err error
+		if slice, ok := flag.Value.(pflag.SliceValue); ok {
+			err = slice.Replace(items)
+		} else {
+			err = flags.Set(flag.Name, strings.Join(items, ","))
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid config file key %s value %q: %w", flag.Name, strings.Join(items, ","), err))
+		}
+	})
+	return errors.Join(errs...)
+}
+
+// EffectiveConfiguration returns the value of every flag once the sources are loaded,
+// with the secret ones redacted, so it can be recorded as evidence of a run
+func EffectiveConfiguration(flags *pflag.FlagSet) map[string]string {
+	configuration := map[string]string{}
+	flags.VisitAll(func(flag *pflag.Flag) {
+		value := flag.Value.String()
+		if value != "" && slices.Contains(secretParameters, flag.Name) {
+			value = redactedValue
+		}
+		configuration[flag.Name] = value
+	})
+	return configuration
+}
+
+// WriteConfiguration records the effective configuration of a run to the file, so the run can be attested later
+func WriteConfiguration(path string, flags *pflag.FlagSet) error {
+	configuration := EffectiveConfiguration(flags)
+	delete(configuration, helpFlag)
+
+	// The redacted values are kept readable
+	var data bytes.Buffer
+	encoder := json.NewEncoder(&data)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(configuration); err != nil {
+		return fmt.Errorf("failed to encode configuration: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create configuration directory: %w", err)
+	}
+	if err := os.WriteFile(path, data.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write configuration: %w", err)
+	}
+	return nil
+}
+
+// sourceValue resolves a flag needed before the others are loaded, with the same precedence
+func sourceValue(flags *pflag.FlagSet, name string, document map[string]any) string {
+	flag := flags.Lookup(name)
+	if flag == nil {
+		return ""
+	}
+	if flag.Changed {
+		return flag.Value.String()
+	}
+	if value, found := os.LookupEnv(EnvName(name)); found {
+		return value
+	}
+	if value, found := document[name]; found && value != nil {
+		return fmt.Sprint(value)
+	}
+	return flag.Value.String()
+}
+
+// resolveProfile flattens the file settings, overridden by the ones of the selected profile
+func resolveProfile(document map[string]any, profile string) (map[string][]string, error) {
+	profiles, _ := document[profilesKey].(map[string]any)
+	if document[profilesKey] != nil && profiles == nil {
+		return nil, fmt.Errorf("invalid config file: key %s must map profile names to settings", profilesKey)
+	}
+
+	values := map[string][]string{}
+	base := maps.Clone(document)
+	delete(base, profilesKey)
+	if err := flattenConfig("", base, values); err != nil {
+		return nil, fmt.Errorf("invalid config file: %w", err)
+	}
+
+	if profile == "" {
+		return values, nil
+	}
+
+	settings, found := profiles[profile]
+	if !found && len(profiles) == 0 {
+		return nil, fmt.Errorf("unknown profile %q: no config file with a %s key was given", profile, profilesKey)
+	}
+	if !found {
+		return nil, fmt.Errorf("unknown profile %q: available profiles are %s", profile, strings.Join(slices.Sorted(maps.Keys(profiles)), ", "))
+	}
+	overrides, _ := settings.(map[string]any)
+	if err := flattenConfig("", overrides, values); err != nil {
+		return nil, fmt.Errorf("invalid config file profile %s: %w", profile, err)
+	}
+	return values, nil
+}
+
+// readConfigFile reads a YAML or JSON file, whose nested keys are joined to flag names
+func readConfigFile(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	// JSON is a subset of YAML, so both formats share the parser
+	var document map[string]any
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return document, nil
+}
+
+func flattenConfig(prefix string, document map[string]any, values map[string][]string) error {
+	for key, value := range document {
+		name := key
+		if prefix != "" {
+			name = prefix + "." + key
+		}
+
+		switch typed := value.(type) {
+		case map[string]any:
+			if err := flattenConfig(name, typed, values); err != nil {
+				return err
+			}
+		case []any:
+			items := make([]string, 0, len(typed))
+			for _, item := range typed {
+				if _, nested := item.(map[string]any); nested {
+					return fmt.Errorf("key %s must be a list of values", name)
+				}
+				items = append(items, fmt.Sprint(item))
+			}
+			values[name] = items
+		case nil:
+			// Empty keys keep the default value
+		default:
+			values[name] = []string{fmt.Sprint(typed)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package har
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+const (
+	harVersion  = "1.2"
+	creatorName = "secatest"
+)
+
+// Log is the HAR 1.2 document of the exchanges of a scenario
+type Log struct {
+	Log Content `json:"log"`
+}
+
+type Content struct {
+	Version string  `json:"version"`
+	Creator Creator `json:"creator"`
+	Entries []Entry `json:"entries"`
+}
+
+type Creator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type Entry struct {
+	StartedDateTime string   `json:"startedDateTime"`
+	Time            float64  `json:"time"`
+	Request         Request  `json:"request"`
+	Response        Response `json:"response"`
+	Cache           struct{} `json:"cache"`
+	Timings         Timings  `json:"timings"`
+	// Error is set when no response was received, as a custom field of the format
+	Error string `json:"_error,omitempty"`
+}
+
+type Request struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []NameValue `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	QueryString []NameValue `json:"queryString"`
+	PostData    *PostData   `json:"postData,omitempty"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+type Response struct {
+	Status      int         `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []NameValue `json:"cookies"`
+	Headers     []NameValue `json:"headers"`
+	Content     Body        `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+type NameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type PostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type Body struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+// Timings are in milliseconds, the time to send the request is not measured
+type Timings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// Recorder collects the exchanges sent with a context carrying it, it is safe for concurrent use
+type Recorder struct {
+	lock    sync.Mutex
+	entries []Entry
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (recorder *Recorder) add(entry Entry) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+
+	recorder.entries = append(recorder.entries, entry)
+}
+
+// Len returns the number of recorded exchanges
+func (recorder *Recorder) Len() int {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+
+	return len(recorder.entries)
+}
+
+// Last returns the latest recorded exchange
+func (recorder *Recorder) Last() (Entry, bool) {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+
+	if len(recorder.entries) == 0 {
+		return Entry{}, false
+	}
+	return recorder.entries[len(recorder.entries)-1], true
+}
+
+// Since returns the exchanges recorded after the given number of them
+func (recorder *Recorder) Since(from int) []Entry {
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+
+	if from >= len(recorder.entries) {
+		return nil
+	}
+	return append([]Entry{}, recorder.entries[from:]...)
+}
+
+// Encode returns the HAR document of the exchanges recorded so far
+func (recorder *Recorder) Encode() ([]byte, error) {
+	recorder.lock.Lock()
+	entries := append([]Entry{}, recorder.entries...)
+	recorder.lock.Unlock()
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(Log{Log: Content{
+		Version: harVersion,
+		Creator: Creator{Name: creatorName, Version: creatorVersion()},
+		Entries: entries,
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode HAR: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+func creatorVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	return "(devel)"
+}
+
+func milliseconds(duration time.Duration) float64 {
+	return float64(duration.Microseconds()) / 1000
+}
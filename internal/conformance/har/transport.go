@@ -0,0 +1,162 @@
+package har
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const redactedValue = "<redacted>"
+
+// redactedHeaders carry the credentials of the client
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization"}
+
+type recorderKey struct{}
+
+var installOnce sync.Once
+
+// WithRecorder records the exchanges sent with the returned context, along with the recorders ctx already carries.
+// The context is unchanged without a recorder
+func WithRecorder(ctx context.Context, recorder *Recorder) context.Context {
+	if recorder == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, recorderKey{}, append(recordersFrom(ctx), recorder))
+}
+
+func recordersFrom(ctx context.Context) []*Recorder {
+	recorders, _ := ctx.Value(recorderKey{}).([]*Recorder)
+	return slices.Clip(recorders)
+}
+
+// Install wraps the default transport, since the clients of the go-sdk are built without an option to set theirs
+func Install() {
+	installOnce.Do(func() {
+		http.DefaultTransport = &Transport{Base: http.DefaultTransport}
+	})
+}
+
+// Transport records the exchanges whose request context carries a recorder, and passes the others through
+type Transport struct {
+	Base http.RoundTripper
+}
+
+func (transport *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorders := recordersFrom(req.Context())
+	if len(recorders) == 0 {
+		return transport.Base.RoundTrip(req)
+	}
+
+	entry := Entry{Request: newRequest(req)}
+	started := time.Now()
+	entry.StartedDateTime = started.Format(time.RFC3339Nano)
+
+	resp, err := transport.Base.RoundTrip(req)
+	responded := time.Now()
+	if err != nil {
+		entry.Error = err.Error()
+		entry.Time = milliseconds(responded.Sub(started))
+		entry.Timings = Timings{Wait: entry.Time}
+		record(recorders, entry)
+		return nil, err
+	}
+
+	// Read the body ahead of the caller, which gets a copy of it
+	body, readErr := io.ReadAll(resp.Body)
+	if closeErr := resp.Body.Close(); readErr == nil {
+		readErr = closeErr
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	received := time.Now()
+
+	entry.Response = newResponse(resp, body)
+	if readErr != nil {
+		entry.Error = fmt.Sprintf("failed to read response body: %v", readErr)
+	}
+	entry.Time = milliseconds(received.Sub(started))
+	entry.Timings = Timings{Wait: milliseconds(responded.Sub(started)), Receive: milliseconds(received.Sub(responded))}
+	record(recorders, entry)
+
+	if readErr != nil {
+		return nil, readErr
+	}
+	return resp, nil
+}
+
+func record(recorders []*Recorder, entry Entry) {
+	for _, recorder := range recorders {
+		recorder.add(entry)
+	}
+}
+
+func newRequest(req *http.Request) Request {
+	request := Request{
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		HTTPVersion: req.Proto,
+		Cookies:     []NameValue{},
+		Headers:     headers(req.Header),
+		QueryString: []NameValue{},
+		HeadersSize: -1,
+	}
+	query := req.URL.Query()
+	for _, name := range slices.Sorted(maps.Keys(query)) {
+		for _, value := range query[name] {
+			request.QueryString = append(request.QueryString, NameValue{Name: name, Value: value})
+		}
+	}
+
+	// The request is sent by the base transport, so its body is read from a copy
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			text, err := io.ReadAll(body)
+			if err == nil && len(text) > 0 {
+				request.PostData = &PostData{MimeType: req.Header.Get("Content-Type"), Text: string(text)}
+			}
+			request.BodySize = len(text)
+		}
+	}
+	return request
+}
+
+func newResponse(resp *http.Response, body []byte) Response {
+	return Response{
+		Status:      resp.StatusCode,
+		StatusText:  strings.TrimSpace(strings.TrimPrefix(resp.Status, fmt.Sprint(resp.StatusCode))),
+		HTTPVersion: resp.Proto,
+		Cookies:     []NameValue{},
+		Headers:     headers(resp.Header),
+		Content:     Body{Size: len(body), MimeType: resp.Header.Get("Content-Type"), Text: string(body)},
+		RedirectURL: resp.Header.Get("Location"),
+		HeadersSize: -1,
+		BodySize:    len(body),
+	}
+}
+
+// headers lists the headers sorted by name, masking the credentials but keeping their scheme, e.g. Bearer
+func headers(header http.Header) []NameValue {
+	list := []NameValue{}
+	for _, name := range slices.Sorted(maps.Keys(header)) {
+		for _, value := range header[name] {
+			if slices.Contains(redactedHeaders, name) {
+				value = redactCredentials(value)
+			}
+			list = append(list, NameValue{Name: name, Value: value})
+		}
+	}
+	return list
+}
+
+func redactCredentials(value string) string {
+	if scheme, _, found := strings.Cut(value, " "); found {
+		return scheme + " " + redactedValue
+	}
+	return redactedValue
+}
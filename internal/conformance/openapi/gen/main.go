@@ -0,0 +1,590 @@
+// Command gen rebuilds the SECA OpenAPI documents of the foundation providers from the models of the go-sdk,
+// since the spec they are generated from is not shipped with its module. Only the constraints of the validation
+// tags are kept: the patterns stated in the comments of the models and the CEL rules are missing
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	sdkModule     = "github.com/eu-sovereign-cloud/go-sdk"
+	specPath      = "pkg/spec"
+	schemaPackage = "schema"
+	apiPrefix     = "foundation."
+	providerScope = "seca."
+	outputDir     = "spec"
+
+	validationTagPrefix = "x-kubebuilder-validation-"
+)
+
+var (
+	jsonResponseField = regexp.MustCompile(`^JSON(\d{3})$`)
+	pathParamsMarker  = regexp.MustCompile(`%s`)
+)
+
+// schema is the subset of the OpenAPI 3.0 schema object the go-sdk models can express
+type schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	AllOf                []*schema          `json:"allOf,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	Items                *schema            `json:"items,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Properties           map[string]*schema `json:"properties,omitempty"`
+	AdditionalProperties *schema            `json:"additionalProperties,omitempty"`
+}
+
+type parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *schema `json:"schema"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+type response struct {
+	Description string               `json:"description"`
+	Content     map[string]mediaType `json:"content,omitempty"`
+}
+
+type operation struct {
+	OperationID string               `json:"operationId"`
+	Tags        []string             `json:"tags"`
+	Parameters  []parameter          `json:"parameters,omitempty"`
+	Responses   map[string]*response `json:"responses"`
+}
+
+type document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       map[string]string                `json:"info"`
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components map[string]map[string]*schema    `json:"components"`
+}
+
+// source is a parsed package of the go-sdk
+type source struct {
+	name  string
+	types map[string]*ast.TypeSpec
+	enums map[string][]any
+	funcs map[string]*ast.FuncDecl
+}
+
+type generator struct {
+	schemas map[string]*schema
+	sources map[string]*source
+}
+
+func main() {
+	dir, err := sdkDir()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := generate(dir, outputDir); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate writes the document of each provider of the go-sdk in dir to output
+func generate(dir string, output string) error {
+	shared, err := parseSource(filepath.Join(dir, specPath, schemaPackage), schemaPackage)
+	if err != nil {
+		return err
+	}
+	apis, err := filepath.Glob(filepath.Join(dir, specPath, apiPrefix+"*"))
+	if err != nil {
+		return err
+	}
+
+	// One document per provider, since their paths overlap, e.g. the skus of compute, network and storage
+	for _, api := range apis {
+		name := strings.TrimPrefix(filepath.Base(api), apiPrefix)
+		src, err := parseSource(api, name)
+		if err != nil {
+			return err
+		}
+
+		gen := &generator{schemas: map[string]*schema{}, sources: map[string]*source{schemaPackage: shared, name: src}}
+		provider, version, _ := strings.Cut(name, ".")
+		doc := document{
+			OpenAPI:    "3.0.3",
+			Info:       map[string]string{"title": providerScope + provider + "/" + version, "version": sdkVersion(dir)},
+			Paths:      map[string]map[string]*operation{},
+			Components: map[string]map[string]*schema{"schemas": gen.schemas},
+		}
+		if err := gen.addOperations(doc.Paths, src); err != nil {
+			return err
+		}
+		if err := writeDocument(filepath.Join(output, providerScope+name+".json"), doc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeDocument(path string, doc document) error {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+	return os.WriteFile(path, buffer.Bytes(), 0o644)
+}
+
+func sdkDir() (string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", sdkModule).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate %s: %w", sdkModule, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+func sdkVersion(dir string) string {
+	_, version, _ := strings.Cut(filepath.Base(dir), "@")
+	return version
+}
+
+func parseSource(dir string, name string) (*source, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	src := &source{name: name, types: map[string]*ast.TypeSpec{}, enums: map[string][]any{}, funcs: map[string]*ast.FuncDecl{}}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		parsed, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		for _, decl := range parsed.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					src.funcs[decl.Name.Name] = decl
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						src.types[spec.Name.Name] = spec
+					case *ast.ValueSpec:
+						addEnumValues(src.enums, decl.Tok, spec)
+					}
+				}
+			}
+		}
+	}
+	return src, nil
+}
+
+// addEnumValues collects the typed constants the go-sdk defines for the enums of the spec
+func addEnumValues(enums map[string][]any, tok token.Token, spec *ast.ValueSpec) {
+	typ, ok := spec.Type.(*ast.Ident)
+	if tok != token.CONST || !ok {
+		return
+	}
+	for _, value := range spec.Values {
+		if literal, ok := value.(*ast.BasicLit); ok {
+			enums[typ.Name] = append(enums[typ.Name], literalValue(literal))
+		}
+	}
+}
+
+func literalValue(literal *ast.BasicLit) any {
+	switch literal.Kind {
+	case token.STRING:
+		value, _ := strconv.Unquote(literal.Value)
+		return value
+	case token.INT:
+		value, _ := strconv.Atoi(literal.Value)
+		return value
+	default:
+		value, _ := strconv.ParseFloat(literal.Value, 64)
+		return value
+	}
+}
+
+func (gen *generator) addOperations(paths map[string]map[string]*operation, src *source) error {
+	names := []string{}
+	for name := range src.funcs {
+		if strings.HasPrefix(name, "New") && strings.HasSuffix(name, "Request") {
+			names = append(names, strings.TrimSuffix(strings.TrimPrefix(name, "New"), "Request"))
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		path, params, method, err := requestOf(src, name)
+		if err != nil {
+			return err
+		}
+		op := &operation{OperationID: name, Tags: []string{src.name}, Responses: map[string]*response{}}
+		for _, param := range params {
+			op.Parameters = append(op.Parameters, parameter{Name: param, In: "path", Required: true, Schema: &schema{Type: "string"}})
+		}
+		if err := gen.addResponses(op, src, name); err != nil {
+			return err
+		}
+		if paths[path] == nil {
+			paths[path] = map[string]*operation{}
+		}
+		paths[path][strings.ToLower(method)] = op
+	}
+	return nil
+}
+
+// requestOf reads the path template, its parameters and the method from the request builders of the operation
+func requestOf(src *source, name string) (string, []string, string, error) {
+	var path, method string
+	var params []string
+	for _, builder := range []string{"New" + name + "Request", "New" + name + "RequestWithBody"} {
+		fn, found := src.funcs[builder]
+		if !found {
+			continue
+		}
+		ast.Inspect(fn.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			switch callName(call) {
+			case "runtime.StyleParamWithLocation":
+				if len(call.Args) > 3 && exprString(call.Args[3]) == "runtime.ParamLocationPath" && !slices.Contains(params, stringArg(call.Args[2])) {
+					params = append(params, stringArg(call.Args[2]))
+				}
+			case "fmt.Sprintf":
+				if path == "" {
+					path = stringArg(call.Args[0])
+				}
+			case "http.NewRequest":
+				method = stringArg(call.Args[0])
+			}
+			return true
+		})
+	}
+	if path == "" || method == "" {
+		return "", nil, "", fmt.Errorf("failed to read the request of %s.%s", src.name, name)
+	}
+	index := 0
+	path = pathParamsMarker.ReplaceAllStringFunc(path, func(string) string {
+		param := "{" + params[index] + "}"
+		index++
+		return param
+	})
+	return path, params, method, nil
+}
+
+func (gen *generator) addResponses(op *operation, src *source, name string) error {
+	spec, found := src.types[name+"Response"]
+	if !found {
+		return fmt.Errorf("failed to find the responses of %s.%s", src.name, name)
+	}
+	accepted := true
+	for _, field := range spec.Type.(*ast.StructType).Fields.List {
+		match := jsonResponseField.FindStringSubmatch(field.Names[0].Name)
+		if match == nil {
+			continue
+		}
+		body, err := gen.schemaOf(src, field.Type)
+		if err != nil {
+			return err
+		}
+		op.Responses[match[1]] = &response{
+			Description: "Response of " + name,
+			Content:     map[string]mediaType{"application/json": {Schema: body}},
+		}
+		if strings.HasPrefix(match[1], "2") {
+			accepted = false
+		}
+	}
+	// The successful responses without a body are not part of the go-sdk models
+	if accepted {
+		op.Responses["2XX"] = &response{Description: "Request accepted"}
+	}
+	return nil
+}
+
+// schemaOf returns the schema of a type expression, declaring the named types as components
+func (gen *generator) schemaOf(src *source, expr ast.Expr) (*schema, error) {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return gen.schemaOf(src, expr.X)
+	case *ast.ArrayType:
+		items, err := gen.schemaOf(src, expr.Elt)
+		if err != nil {
+			return nil, err
+		}
+		return &schema{Type: "array", Items: items}, nil
+	case *ast.MapType:
+		values, err := gen.schemaOf(src, expr.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &schema{Type: "object", AdditionalProperties: values}, nil
+	case *ast.InterfaceType:
+		return &schema{}, nil
+	case *ast.SelectorExpr:
+		switch exprString(expr) {
+		case "time.Time":
+			return &schema{Type: "string", Format: "date-time"}, nil
+		case "openapi_types.Email":
+			return &schema{Type: "string", Format: "email"}, nil
+		}
+		return gen.namedSchema(gen.sources[schemaPackage], expr.Sel.Name)
+	case *ast.StructType:
+		return gen.structSchema(src, expr)
+	case *ast.Ident:
+		if basic := basicSchema(expr.Name); basic != nil {
+			return basic, nil
+		}
+		return gen.namedSchema(src, expr.Name)
+	}
+	return nil, fmt.Errorf("unsupported type %s in %s", exprString(expr), src.name)
+}
+
+func basicSchema(name string) *schema {
+	switch name {
+	case "string":
+		return &schema{Type: "string"}
+	case "bool":
+		return &schema{Type: "boolean"}
+	case "int", "int32":
+		return &schema{Type: "integer"}
+	case "int64":
+		return &schema{Type: "integer", Format: "int64"}
+	case "float32":
+		return &schema{Type: "number"}
+	case "float64":
+		return &schema{Type: "number", Format: "double"}
+	}
+	return nil
+}
+
+func (gen *generator) namedSchema(src *source, name string) (*schema, error) {
+	component := name
+	if src.name != schemaPackage {
+		if _, shared := gen.sources[schemaPackage].types[name]; shared {
+			return nil, fmt.Errorf("type %s of %s shadows the shared schema", name, src.name)
+		}
+	}
+	ref := &schema{Ref: "#/components/schemas/" + component}
+	if _, found := gen.schemas[component]; found {
+		return ref, nil
+	}
+	spec, found := src.types[name]
+	if !found {
+		return nil, fmt.Errorf("failed to find type %s in %s", name, src.name)
+	}
+
+	// Declared ahead of its schema, for the recursive types
+	gen.schemas[component] = &schema{}
+	value, err := gen.schemaOf(src, spec.Type)
+	if err != nil {
+		return nil, err
+	}
+	if values, found := src.enums[name]; found {
+		value.Enum = values
+	}
+	gen.schemas[component] = value
+	return ref, nil
+}
+
+func (gen *generator) structSchema(src *source, expr *ast.StructType) (*schema, error) {
+	value := &schema{Type: "object", Properties: map[string]*schema{}}
+	for _, field := range expr.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+		tag, _ := strconv.Unquote(field.Tag.Value)
+		name, options, _ := strings.Cut(structTag(tag, "json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		if len(field.Names) == 0 {
+			return nil, fmt.Errorf("unsupported embedded field %s in %s", name, src.name)
+		}
+		property, err := gen.schemaOf(src, field.Type)
+		if err != nil {
+			return nil, err
+		}
+		value.Properties[name] = withConstraints(property, tag)
+		if !strings.Contains(options, "omitempty") {
+			value.Required = append(value.Required, name)
+		}
+	}
+	slices.Sort(value.Required)
+	return value, nil
+}
+
+// withConstraints applies the validation tags of a field, next to its reference since siblings of $ref are ignored
+func withConstraints(property *schema, tag string) *schema {
+	constraints := &schema{}
+	found := false
+	for _, key := range []string{"pattern", "enum", "min-length", "max-length", "min-items", "max-items", "minimum", "maximum",
+		"items-min-length", "items-max-length", "items-minimum", "items-maximum"} {
+		raw := structTag(tag, validationTagPrefix+key)
+		if raw == "" {
+			continue
+		}
+		found = true
+		target := constraints
+		if item, isItem := strings.CutPrefix(key, "items-"); isItem {
+			if constraints.Items == nil {
+				constraints.Items = &schema{}
+			}
+			target, key = constraints.Items, item
+		}
+		switch key {
+		case "pattern":
+			target.Pattern = raw
+		case "enum":
+			for _, value := range strings.Split(raw, ";") {
+				target.Enum = append(target.Enum, value)
+			}
+		case "min-length":
+			target.MinLength = intValue(raw)
+		case "max-length":
+			target.MaxLength = intValue(raw)
+		case "min-items":
+			target.MinItems = intValue(raw)
+		case "max-items":
+			target.MaxItems = intValue(raw)
+		case "minimum":
+			target.Minimum = floatValue(raw)
+		case "maximum":
+			target.Maximum = floatValue(raw)
+		}
+	}
+	if !found {
+		return property
+	}
+	if property.Ref == "" && property.AllOf == nil {
+		merge(property, constraints)
+		return property
+	}
+	return &schema{AllOf: []*schema{property, constraints}}
+}
+
+func merge(property *schema, constraints *schema) {
+	if constraints.Pattern != "" {
+		property.Pattern = constraints.Pattern
+	}
+	if constraints.Enum != nil {
+		property.Enum = constraints.Enum
+	}
+	property.MinLength = cmpOr(constraints.MinLength, property.MinLength)
+	property.MaxLength = cmpOr(constraints.MaxLength, property.MaxLength)
+	property.MinItems = cmpOr(constraints.MinItems, property.MinItems)
+	property.MaxItems = cmpOr(constraints.MaxItems, property.MaxItems)
+	property.Minimum = cmpOr(constraints.Minimum, property.Minimum)
+	property.Maximum = cmpOr(constraints.Maximum, property.Maximum)
+	if constraints.Items != nil {
+		if property.Items == nil || property.Items.Ref != "" {
+			property.Items = &schema{AllOf: []*schema{property.Items, constraints.Items}}
+		} else {
+			merge(property.Items, constraints.Items)
+		}
+	}
+}
+
+func cmpOr[T any](values ...*T) *T {
+	for _, value := range values {
+		if value != nil {
+			return value
+		}
+	}
+	return nil
+}
+
+func intValue(raw string) *int {
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		log.Fatalf("invalid integer constraint %q: %v", raw, err)
+	}
+	return &value
+}
+
+func floatValue(raw string) *float64 {
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		log.Fatalf("invalid number constraint %q: %v", raw, err)
+	}
+	return &value
+}
+
+// structTag looks a key up like reflect.StructTag, whose Lookup cannot be used on tags read from the source
+func structTag(tag string, key string) string {
+	for tag != "" {
+		tag = strings.TrimLeft(tag, " ")
+		name, rest, found := strings.Cut(tag, ":")
+		if !found || !strings.HasPrefix(rest, `"`) {
+			return ""
+		}
+		end := 1
+		for end < len(rest) && rest[end] != '"' {
+			if rest[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(rest) {
+			return ""
+		}
+		if name == key {
+			value, _ := strconv.Unquote(rest[:end+1])
+			return value
+		}
+		tag = rest[end+1:]
+	}
+	return ""
+}
+
+func callName(call *ast.CallExpr) string {
+	return exprString(call.Fun)
+}
+
+func exprString(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.SelectorExpr:
+		return exprString(expr.X) + "." + expr.Sel.Name
+	case *ast.StarExpr:
+		return "*" + exprString(expr.X)
+	}
+	return fmt.Sprintf("%T", expr)
+}
+
+func stringArg(expr ast.Expr) string {
+	if literal, ok := expr.(*ast.BasicLit); ok && literal.Kind == token.STRING {
+		value, _ := strconv.Unquote(literal.Value)
+		return value
+	}
+	return ""
+}
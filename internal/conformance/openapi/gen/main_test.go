@@ -0,0 +1,101 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateMatchesDocuments(t *testing.T) {
+	dir, err := sdkDir()
+	require.NoError(t, err)
+
+	output := t.TempDir()
+	require.NoError(t, generate(dir, output))
+
+	generated, err := filepath.Glob(filepath.Join(output, "*.json"))
+	require.NoError(t, err)
+	committed, err := filepath.Glob(filepath.Join("..", outputDir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, generated, len(committed), "Should generate one document per committed document")
+
+	for _, path := range generated {
+		want, err := os.ReadFile(filepath.Join("..", outputDir, filepath.Base(path)))
+		require.NoError(t, err, "Should have committed %s, run make spec", filepath.Base(path))
+		got, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, string(want), string(got), "%s is out of date, run make spec", filepath.Base(path))
+	}
+}
+
+func TestStructTag(t *testing.T) {
+	tag := `json:"name,omitempty" x-kubebuilder-validation-pattern:"^[a-z]\"+$" x-kubebuilder-validation-max-length:"63"`
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{key: "json", want: "name,omitempty"},
+		{key: "x-kubebuilder-validation-pattern", want: `^[a-z]"+$`},
+		{key: "x-kubebuilder-validation-max-length", want: "63"},
+		{key: "x-kubebuilder-validation-enum", want: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			assert.Equal(t, test.want, structTag(tag, test.key))
+		})
+	}
+}
+
+func TestWithConstraints(t *testing.T) {
+	tests := []struct {
+		name     string
+		property *schema
+		tag      string
+		want     *schema
+	}{
+		{
+			name:     "no constraints",
+			property: &schema{Type: "string"},
+			tag:      `json:"zone"`,
+			want:     &schema{Type: "string"},
+		},
+		{
+			name:     "lengths and pattern",
+			property: &schema{Type: "string"},
+			tag:      `json:"name" x-kubebuilder-validation-pattern:"^[a-z]+$" x-kubebuilder-validation-min-length:"1" x-kubebuilder-validation-max-length:"63"`,
+			want:     &schema{Type: "string", Pattern: "^[a-z]+$", MinLength: intValue("1"), MaxLength: intValue("63")},
+		},
+		{
+			name:     "enum",
+			property: &schema{Type: "string"},
+			tag:      `json:"boot" x-kubebuilder-validation-enum:"UEFI;BIOS"`,
+			want:     &schema{Type: "string", Enum: []any{"UEFI", "BIOS"}},
+		},
+		{
+			name:     "items",
+			property: &schema{Type: "array", Items: &schema{Type: "string"}},
+			tag:      `json:"subs" x-kubebuilder-validation-max-items:"256" x-kubebuilder-validation-items-min-length:"1"`,
+			want:     &schema{Type: "array", Items: &schema{Type: "string", MinLength: intValue("1")}, MaxItems: intValue("256")},
+		},
+		{
+			name:     "reference",
+			property: &schema{Ref: "#/components/schemas/Reference"},
+			tag:      `json:"skuRef" x-kubebuilder-validation-max-length:"256"`,
+			want: &schema{AllOf: []*schema{
+				{Ref: "#/components/schemas/Reference"},
+				{MaxLength: intValue("256")},
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, withConstraints(test.property, test.tag))
+		})
+	}
+}
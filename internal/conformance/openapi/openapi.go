@@ -0,0 +1,154 @@
+// Package openapi validates the responses of the providers against the SECA OpenAPI documents embedded in the binary.
+// The documents are rebuilt from the models of the go-sdk, run go generate after upgrading it. They only carry the
+// constraints of its validation tags, so the patterns and CEL rules of the upstream spec are not checked
+package openapi
+
+//go:generate go run ./gen
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+)
+
+//go:embed spec/*.json
+var specs embed.FS
+
+// Violation is a part of a response that does not conform to the document of its provider
+type Violation struct {
+	// Pointer is the JSON pointer of the violating value in the response body, empty for the response itself
+	Pointer string
+	Reason  string
+}
+
+// Validator finds the document of a response by the URL of its provider
+type Validator struct {
+	routers []routers.Router
+}
+
+// NewValidator loads the documents of the providers, keyed by name and version as in seca.compute/v1, with their URL.
+// The providers without a URL or a document, e.g. the extensions, are not validated
+func NewValidator(providers map[string]string) (*Validator, error) {
+	validator := &Validator{}
+	loader := openapi3.NewLoader()
+	for name, url := range providers {
+		if url == "" {
+			continue
+		}
+		data, err := specs.ReadFile("spec/" + strings.ReplaceAll(name, "/", ".") + ".json")
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the document of %s: %w", name, err)
+		}
+
+		doc, err := loader.LoadFromData(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the document of %s: %w", name, err)
+		}
+		doc.Servers = openapi3.Servers{{URL: strings.TrimSuffix(url, "/")}}
+		if err := doc.Validate(loader.Context); err != nil {
+			return nil, fmt.Errorf("invalid document of %s: %w", name, err)
+		}
+
+		router, err := legacy.NewRouter(doc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to route the document of %s: %w", name, err)
+		}
+		validator.routers = append(validator.routers, router)
+	}
+	return validator, nil
+}
+
+// Validate returns the violations of the response of an exchange, none when it has no response or its operation is unknown
+func (validator *Validator) Validate(ctx context.Context, entry har.Entry) []Violation {
+	if entry.Error != "" || entry.Response.Status == 0 {
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, entry.Request.Method, entry.Request.URL, nil)
+	if err != nil {
+		return nil
+	}
+
+	for _, router := range validator.routers {
+		route, pathParams, err := router.FindRoute(req)
+		if err != nil {
+			continue
+		}
+
+		header := http.Header{}
+		for _, value := range entry.Response.Headers {
+			header.Add(value.Name, value.Value)
+		}
+		err = openapi3filter.ValidateResponse(ctx, &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: &openapi3filter.RequestValidationInput{Request: req, PathParams: pathParams, Route: route},
+			Status:                 entry.Response.Status,
+			Header:                 header,
+			Body:                   io.NopCloser(strings.NewReader(entry.Response.Content.Text)),
+			Options:                &openapi3filter.Options{MultiError: true, IncludeResponseStatus: true},
+		})
+		return slices.Compact(violations(err))
+	}
+	return nil
+}
+
+// violations flattens the errors of the validation, down to the values of the body
+func violations(err error) []Violation {
+	if err == nil {
+		return nil
+	}
+
+	var multi openapi3.MultiError
+	if errors.As(err, &multi) {
+		var list []Violation
+		for _, err := range multi {
+			list = append(list, violations(err)...)
+		}
+		return list
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		var nested *openapi3.SchemaError
+		if errors.As(schemaErr.Origin, &nested) || errors.As(schemaErr.Origin, &multi) {
+			return violations(schemaErr.Origin)
+		}
+		reason := schemaErr.Reason
+		if schemaErr.Origin != nil {
+			reason = schemaErr.Origin.Error()
+		}
+		return []Violation{{Pointer: pointer(schemaErr.JSONPointer()), Reason: reason}}
+	}
+
+	var responseErr *openapi3filter.ResponseError
+	if errors.As(err, &responseErr) {
+		if responseErr.Err != nil {
+			return violations(responseErr.Err)
+		}
+		return []Violation{{Reason: responseErr.Reason}}
+	}
+	return []Violation{{Reason: err.Error()}}
+}
+
+// pointer escapes the path as in RFC 6901
+func pointer(path []string) string {
+	var builder strings.Builder
+	for _, token := range path {
+		builder.WriteString("/")
+		builder.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+	return builder.String()
+}
@@ -0,0 +1,113 @@
+package progress
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http/httptrace"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// Mode sets how the progress of a run is shown
+type Mode string
+
+const (
+	AutoMode  Mode = "auto"
+	TTYMode   Mode = "tty"
+	PlainMode Mode = "plain"
+	OffMode   Mode = "off"
+)
+
+// Outcome of a finished scenario
+type Outcome string
+
+const (
+	Passed  Outcome = "passed"
+	Failed  Outcome = "failed"
+	Skipped Outcome = "skipped"
+)
+
+// current is the reporter of the running command, the hooks do nothing without one
+var current atomic.Pointer[Reporter]
+
+func ParseMode(name string) (Mode, error) {
+	switch mode := Mode(strings.ToLower(name)); mode {
+	case AutoMode, TTYMode, PlainMode, OffMode:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown progress mode %q, must be one of auto, tty, plain, off", name)
+	}
+}
+
+// Start shows the progress of the run on out until Stop, as a view redrawn in place in tty mode,
+// or in auto mode when out is a terminal, and as plain lines otherwise.
+// It returns the writer the logs must go through, so they are printed above the view instead of across it
+func Start(out *os.File, mode Mode) io.Writer {
+	if mode == OffMode {
+		return out
+	}
+	if mode == AutoMode || mode == "" {
+		mode = PlainMode
+		if isTerminal(out) {
+			mode = TTYMode
+		}
+	}
+
+	reporter := newReporter(out, mode == TTYMode)
+	current.Store(reporter)
+	if reporter.interactive {
+		reporter.startTicker()
+		return reporter
+	}
+	return out
+}
+
+// Stop clears the view and prints the final counts
+func Stop() {
+	if reporter := current.Swap(nil); reporter != nil {
+		reporter.stop()
+	}
+}
+
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// Hooks
+
+func ScenarioStarted(scenario string) {
+	if reporter := current.Load(); reporter != nil {
+		reporter.scenarioStarted(scenario)
+	}
+}
+
+func ScenarioFinished(scenario string, outcome Outcome) {
+	if reporter := current.Load(); reporter != nil {
+		reporter.scenarioFinished(scenario, outcome)
+	}
+}
+
+func StepStarted(scenario string, step string) {
+	if reporter := current.Load(); reporter != nil {
+		reporter.stepStarted(scenario, step)
+	}
+}
+
+// ObserveAttempts reports every request sent with the returned context as an attempt of the current step,
+// since the observers of the go-sdk poll the provider without exposing their attempt number
+func ObserveAttempts(ctx context.Context, scenario string, maxAttempts int) context.Context {
+	reporter := current.Load()
+	if reporter == nil {
+		return ctx
+	}
+
+	var attempts atomic.Int32
+	return httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GetConn: func(string) {
+			reporter.attempt(scenario, int(attempts.Add(1)), maxAttempts)
+		},
+	})
+}
@@ -0,0 +1,241 @@
+package progress
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	refreshInterval = time.Second
+	defaultWidth    = 80
+)
+
+// Reporter tracks the running scenarios and counts the finished ones
+type Reporter struct {
+	lock        sync.Mutex
+	out         io.Writer
+	interactive bool
+	width       int
+	started     time.Time
+
+	running []*scenarioProgress
+	counts  map[Outcome]int
+
+	// drawn is the number of lines of the view currently shown
+	drawn   int
+	stopped bool
+	done    chan struct{}
+	wait    sync.WaitGroup
+}
+
+type scenarioProgress struct {
+	name        string
+	step        string
+	stepStarted time.Time
+	attempt     int
+	maxAttempts int
+}
+
+func newReporter(out io.Writer, interactive bool) *Reporter {
+	return &Reporter{
+		out:         out,
+		interactive: interactive,
+		width:       terminalWidth(),
+		started:     time.Now(),
+		counts:      map[Outcome]int{},
+		done:        make(chan struct{}),
+	}
+}
+
+// terminalWidth reads the width exported by the shell, lines longer than it would break the redraw
+func terminalWidth() int {
+	if width, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && width > 0 {
+		return width
+	}
+	return defaultWidth
+}
+
+// Write prints the logs above the view
+func (r *Reporter) Write(p []byte) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.clear()
+	n, err := r.out.Write(p)
+	r.draw()
+	return n, err
+}
+
+func (r *Reporter) startTicker() {
+	r.wait.Go(func() {
+		ticker := time.NewTicker(refreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.refresh()
+			case <-r.done:
+				return
+			}
+		}
+	})
+}
+
+func (r *Reporter) stop() {
+	close(r.done)
+	r.wait.Wait()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.clear()
+	r.stopped = true
+	fmt.Fprintf(r.out, "Finished in %s: %s\n", r.elapsed(), r.countsText())
+}
+
+func (r *Reporter) scenarioStarted(name string) {
+	r.update(func() *scenarioProgress {
+		scenario := &scenarioProgress{name: name, stepStarted: time.Now()}
+		r.running = append(r.running, scenario)
+		return scenario
+	}, "started")
+}
+
+func (r *Reporter) scenarioFinished(name string, outcome Outcome) {
+	r.update(func() *scenarioProgress {
+		r.counts[outcome]++
+		index := slices.IndexFunc(r.running, func(scenario *scenarioProgress) bool { return scenario.name == name })
+		if index < 0 {
+			return &scenarioProgress{name: name}
+		}
+		scenario := r.running[index]
+		r.running = slices.Delete(r.running, index, index+1)
+		return scenario
+	}, string(outcome))
+}
+
+func (r *Reporter) stepStarted(name string, step string) {
+	r.update(func() *scenarioProgress {
+		scenario := r.scenario(name)
+		scenario.step = step
+		scenario.stepStarted = time.Now()
+		scenario.attempt, scenario.maxAttempts = 0, 0
+		return scenario
+	}, "")
+}
+
+func (r *Reporter) attempt(name string, attempt int, maxAttempts int) {
+	r.update(func() *scenarioProgress {
+		scenario := r.scenario(name)
+		scenario.attempt, scenario.maxAttempts = attempt, maxAttempts
+		return scenario
+	}, "")
+}
+
+// scenario returns the progress of a running scenario, tracking it when its start was not reported
+func (r *Reporter) scenario(name string) *scenarioProgress {
+	for _, scenario := range r.running {
+		if scenario.name == name {
+			return scenario
+		}
+	}
+	scenario := &scenarioProgress{name: name, stepStarted: time.Now()}
+	r.running = append(r.running, scenario)
+	return scenario
+}
+
+// update applies a change, then redraws the view or prints the changed scenario as a plain line
+func (r *Reporter) update(change func() *scenarioProgress, event string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.clear()
+	scenario := change()
+	if r.interactive {
+		r.draw()
+		return
+	}
+	fmt.Fprintln(r.out, r.plainLine(scenario, event))
+}
+
+func (r *Reporter) refresh() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.clear()
+	r.draw()
+}
+
+// Rendering
+
+func (r *Reporter) plainLine(scenario *scenarioProgress, event string) string {
+	var line strings.Builder
+	fmt.Fprintf(&line, "progress elapsed=%s passed=%d failed=%d skipped=%d running=%d scenario=%s",
+		r.elapsed(), r.counts[Passed], r.counts[Failed], r.counts[Skipped], len(r.running), scenario.name)
+	if event != "" {
+		fmt.Fprintf(&line, " event=%s", event)
+		return line.String()
+	}
+	fmt.Fprintf(&line, " step=%q", scenario.step)
+	if scenario.attempt > 0 {
+		fmt.Fprintf(&line, " attempt=%d/%d", scenario.attempt, scenario.maxAttempts)
+	}
+	return line.String()
+}
+
+// draw shows the view, until the reporter is stopped and the logs written through it are printed as is
+func (r *Reporter) draw() {
+	if !r.interactive || r.stopped {
+		return
+	}
+
+	lines := []string{fmt.Sprintf("Elapsed %s | %s | %d running", r.elapsed(), r.countsText(), len(r.running))}
+	for _, scenario := range r.running {
+		line := "  " + scenario.name
+		if scenario.step != "" {
+			line += " > " + scenario.step
+		}
+		if scenario.attempt > 0 {
+			line += fmt.Sprintf(", attempt %d/%d", scenario.attempt, scenario.maxAttempts)
+		}
+		line += fmt.Sprintf(" (%s)", time.Since(scenario.stepStarted).Round(time.Second))
+		lines = append(lines, line)
+	}
+
+	for _, line := range lines {
+		fmt.Fprintln(r.out, truncate(line, r.width))
+	}
+	r.drawn = len(lines)
+}
+
+// clear moves the cursor back to the first line of the view and erases it
+func (r *Reporter) clear() {
+	if r.drawn == 0 {
+		return
+	}
+	fmt.Fprintf(r.out, "\x1b[%dA\x1b[J", r.drawn)
+	r.drawn = 0
+}
+
+func (r *Reporter) elapsed() time.Duration {
+	return time.Since(r.started).Round(time.Second)
+}
+
+func (r *Reporter) countsText() string {
+	return fmt.Sprintf("%d passed, %d failed, %d skipped", r.counts[Passed], r.counts[Failed], r.counts[Skipped])
+}
+
+func truncate(line string, width int) string {
+	runes := []rune(line)
+	if len(runes) < width {
+		return line
+	}
+	// Keep the last column free, so the terminal does not wrap the line
+	return string(runes[:width-2]) + "…"
+}
@@ -0,0 +1,174 @@
+package selector
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Tag keys a selector term can match
+const (
+	NameKey     = "name"
+	KindKey     = "kind"
+	ProviderKey = "provider"
+	ResourceKey = "resource"
+	DependsKey  = "depends"
+)
+
+var keys = []string{NameKey, KindKey, ProviderKey, ResourceKey, DependsKey}
+
+type term struct {
+	key     string
+	pattern string
+	expr    *regexp.Regexp
+}
+
+// expression matches when all of its terms match
+type expression []term
+
+// Selector chooses the scenarios to run by name and by the tags declared on their suites
+type Selector struct {
+	filter   *regexp.Regexp
+	includes []expression
+	excludes []expression
+}
+
+// Parse builds a selector from the name filter regexp and the include and exclude expressions,
+// written as comma-separated key=pattern terms, e.g. "provider=seca.network,kind=Error"
+func Parse(filter string, includes []string, excludes []string) (*Selector, error) {
+	var errs []error
+	selector := &Selector{}
+
+	if filter != "" {
+		expr, err := regexp.Compile(filter)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid scenarios.filter expression: %w", err))
+		}
+		selector.filter = expr
+	}
+
+	for _, text := range includes {
+		expr, err := parseExpression(text)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid select expression %q: %w", text, err))
+		}
+		selector.includes = append(selector.includes, expr)
+	}
+	for _, text := range excludes {
+		expr, err := parseExpression(text)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid exclude expression %q: %w", text, err))
+		}
+		selector.excludes = append(selector.excludes, expr)
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return selector, nil
+}
+
+func parseExpression(text string) (expression, error) {
+	var expr expression
+	for part := range strings.SplitSeq(text, ",") {
+		key, pattern, found := strings.Cut(strings.TrimSpace(part), "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+
+		if !found || pattern == "" {
+			return nil, fmt.Errorf("term %q must be written as key=pattern", part)
+		}
+		if !slices.Contains(keys, key) {
+			return nil, fmt.Errorf("unknown key %q, must be one of %s", key, strings.Join(keys, ", "))
+		}
+		compiled, err := compilePattern(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		expr = append(expr, term{key: key, pattern: pattern, expr: compiled})
+	}
+	return expr, nil
+}
+
+// Matches reports whether the scenario is selected: it matches the name filter, any of
+// the include expressions, if some were given, and none of the exclude expressions
+func (selector *Selector) Matches(name string, tags map[string][]string) bool {
+	if selector == nil {
+		return true
+	}
+
+	if selector.filter != nil && !selector.filter.MatchString(name) {
+		return false
+	}
+
+	values := map[string][]string{NameKey: {name}}
+	for key, tagValues := range tags {
+		values[key] = tagValues
+	}
+
+	if len(selector.includes) > 0 && !slices.ContainsFunc(selector.includes, func(expr expression) bool {
+		return expr.matches(values)
+	}) {
+		return false
+	}
+	return !slices.ContainsFunc(selector.excludes, func(expr expression) bool {
+		return expr.matches(values)
+	})
+}
+
+func (expr expression) matches(values map[string][]string) bool {
+	for _, term := range expr {
+		if !slices.ContainsFunc(values[term.key], term.matches) {
+			return false
+		}
+	}
+	return true
+}
+
+func (term term) matches(value string) bool {
+	value = strings.ToLower(value)
+
+	// Provider names can omit their version, e.g. seca.network for seca.network/v1
+	if strings.HasPrefix(value, term.pattern+"/") {
+		return true
+	}
+	return term.expr.MatchString(value)
+}
+
+// compilePattern turns a glob pattern into a regexp matching the whole value: * matches any
+// characters, including the / of the provider versions, ? a single one and [...] one of a class
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end <= 0 {
+				return nil, errors.New("unterminated character class")
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr.WriteString("[" + class + "]")
+			i += end + 1
+		case '\\':
+			if i+1 == len(pattern) {
+				return nil, errors.New("trailing escape character")
+			}
+			i++
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		default:
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	expr.WriteString("$")
+	return regexp.Compile(expr.String())
+}
@@ -0,0 +1,110 @@
+package selector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		includes []string
+		excludes []string
+		wantErr  string
+	}{
+		{name: "empty"},
+		{name: "filter", filter: "^Network.*V1$"},
+		{name: "terms", includes: []string{"provider=seca.network,kind=Error"}, excludes: []string{"resource=*gateway*"}},
+		{name: "spaces and case", includes: []string{" Kind = Error , PROVIDER = seca.* "}},
+		{name: "character class", includes: []string{"kind=[lq]*"}},
+		{name: "invalid filter", filter: "(", wantErr: "invalid scenarios.filter expression"},
+		{name: "missing pattern", includes: []string{"kind="}, wantErr: `term "kind=" must be written as key=pattern`},
+		{name: "missing separator", includes: []string{"kind"}, wantErr: `term "kind" must be written as key=pattern`},
+		{name: "unknown key", includes: []string{"level=core"}, wantErr: `unknown key "level"`},
+		{name: "unterminated class", excludes: []string{"kind=[abc"}, wantErr: "invalid exclude expression"},
+		{name: "trailing escape", includes: []string{`name=foo\`}, wantErr: "trailing escape character"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			selector, err := Parse(test.filter, test.includes, test.excludes)
+			if test.wantErr != "" {
+				require.ErrorContains(t, err, test.wantErr)
+				assert.Nil(t, selector)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, selector)
+		})
+	}
+}
+
+func TestParseReportsEveryError(t *testing.T) {
+	_, err := Parse("(", []string{"level=core"}, []string{"kind="})
+
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "invalid scenarios.filter expression")
+	assert.ErrorContains(t, err, "invalid select expression")
+	assert.ErrorContains(t, err, "invalid exclude expression")
+}
+
+func TestMatches(t *testing.T) {
+	name := "NetworkSubnetErrorV1"
+	tags := map[string][]string{
+		KindKey:     {"Error"},
+		ProviderKey: {"seca.network/v1", "seca.workspace/v1"},
+		ResourceKey: {"network", "subnet"},
+		DependsKey:  {"seca.region/v1"},
+	}
+
+	tests := []struct {
+		name     string
+		filter   string
+		includes []string
+		excludes []string
+		want     bool
+	}{
+		{name: "no selection", want: true},
+		{name: "filter matching", filter: "^Network", want: true},
+		{name: "filter not matching", filter: "^Compute", want: false},
+		{name: "name", includes: []string{"name=network*"}, want: true},
+		{name: "kind ignoring case", includes: []string{"kind=error"}, want: true},
+		{name: "kind not matching", includes: []string{"kind=LifeCycle"}, want: false},
+		{name: "provider without version", includes: []string{"provider=seca.network"}, want: true},
+		{name: "provider with version", includes: []string{"provider=seca.network/v1"}, want: true},
+		{name: "provider wildcard spanning version", includes: []string{"provider=seca.net*"}, want: true},
+		{name: "provider wildcard on version", includes: []string{"provider=seca.*/v1"}, want: true},
+		{name: "provider other version", includes: []string{"provider=seca.network/v2"}, want: false},
+		{name: "provider prefix of another name", includes: []string{"provider=seca.net"}, want: false},
+		{name: "single character wildcard", includes: []string{"resource=subne?"}, want: true},
+		{name: "character class", includes: []string{"kind=[ef]rror"}, want: true},
+		{name: "negated character class", includes: []string{"kind=[!e]rror"}, want: false},
+		{name: "depends", includes: []string{"depends=seca.region"}, want: true},
+		{name: "missing tag", includes: []string{"depends=seca.compute"}, want: false},
+		{name: "all terms matching", includes: []string{"provider=seca.network,kind=Error"}, want: true},
+		{name: "one term not matching", includes: []string{"provider=seca.network,kind=Queries"}, want: false},
+		{name: "any expression matching", includes: []string{"kind=Queries", "resource=subnet"}, want: true},
+		{name: "excluded", excludes: []string{"resource=subnet"}, want: false},
+		{name: "not excluded", excludes: []string{"resource=instance"}, want: true},
+		{name: "selected and excluded", includes: []string{"kind=Error"}, excludes: []string{"provider=seca.workspace"}, want: false},
+		{name: "filter and selection", filter: "^Compute", includes: []string{"kind=Error"}, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			selector, err := Parse(test.filter, test.includes, test.excludes)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.want, selector.Matches(name, tags))
+		})
+	}
+}
+
+func TestMatchesNilSelector(t *testing.T) {
+	var selector *Selector
+
+	assert.True(t, selector.Matches("NetworkSubnetErrorV1", nil))
+}
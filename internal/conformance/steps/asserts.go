@@ -1,10 +1,12 @@
 package steps
 
 import (
+	"encoding/json"
 	"fmt"
 
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/types"
-	"github.com/eu-sovereign-cloud/go-sdk/secapi"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 )
 
@@ -15,11 +17,29 @@ func requireNoError(sCtx provider.StepCtx, err error) {
 	})
 }
 
-func requirePreConditionFailedError(sCtx provider.StepCtx, err error) {
+// requireExpectedError checks the status and problem details of the error response, since the go-sdk errors
+// do not tell apart every status code
+func requireExpectedError(sCtx provider.StepCtx, err error, response *har.Response, expected ExpectedError) {
 	sCtx.WithNewStep("Verify error returned", func(stepCtx provider.StepCtx) {
-		stepCtx.WithNewParameters("error", fmt.Sprintf("%v", err))
+		stepCtx.WithNewParameters("error", fmt.Sprintf("%v", err), "expected status", expected.StatusCode, "expected type", expected.Type)
+		if expected.Pointer != "" {
+			stepCtx.WithNewParameters("expected pointer", expected.Pointer)
+		}
 		stepCtx.Require().Error(err, "Should return an error")
-		stepCtx.Require().Equal(err.Error(), secapi.ErrRequestPreconditionFailed.Error(), "expected error message")
+		stepCtx.Require().NotNil(response, "Should receive an error response")
+		stepCtx.Require().Equal(expected.StatusCode, response.Status, "Should return the expected status code")
+
+		var problem schema.Error
+		stepCtx.Require().NoError(json.Unmarshal([]byte(response.Content.Text), &problem), "Should return a problem details body")
+		stepCtx.Require().Equal(string(expected.Type), problem.Type, "Should return the expected error type")
+
+		if expected.Pointer != "" {
+			var pointers []string
+			for _, source := range problem.Sources {
+				pointers = append(pointers, source.Pointer)
+			}
+			stepCtx.Require().Contains(pointers, expected.Pointer, "Should point at the rejected field")
+		}
 	})
 }
 
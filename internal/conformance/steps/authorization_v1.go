@@ -18,16 +18,16 @@ func (configurator *StepsConfigurator) CreateOrUpdateRoleV1Step(stepName string,
 	responseExpects ResponseExpects[schema.GlobalTenantResourceMetadata, schema.RoleSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateTenantResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateTenantResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateTenantResourceParams[schema.Role, schema.GlobalTenantResourceMetadata, schema.RoleSpec, schema.Status]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetAuthorizationV1StepParams,
 			operationName:  constants.CreateOrUpdateRoleOperation,
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.Role) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.Role) (
 				wrappers.ResourceWrapper[schema.Role, schema.GlobalTenantResourceMetadata, schema.RoleSpec, schema.Status], error,
 			) {
-				resp, err := api.CreateOrUpdateRole(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateRole(ctx, resource)
 				return wrappers.NewRoleWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -38,6 +38,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateRoleV1Step(stepName string,
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifyRoleSpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            RoleV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -91,12 +92,13 @@ func (configurator *StepsConfigurator) GetRoleV1Step(stepName string, api secapi
 }
 
 func (configurator *StepsConfigurator) WatchRoleUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.AuthorizationV1, tref secapi.TenantReference) {
-	watchTenantResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchTenantResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchTenantResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.TenantReference]{
-				reference: tref,
+				reference:       tref,
+				ledgerReference: roleV1LedgerReference(tref),
 				getErrorFunc: func(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchRoleUntilDeleted(configurator.t.Context(), tref, config)
+					return api.WatchRoleUntilDeleted(ctx, tref, config)
 				},
 			},
 			stepName:       stepName,
@@ -107,7 +109,7 @@ func (configurator *StepsConfigurator) WatchRoleUntilDeletedV1Step(stepName stri
 }
 
 func (configurator *StepsConfigurator) DeleteRoleV1Step(stepName string, stepCreator StepCreator, api secapi.AuthorizationV1, resource *schema.Role) {
-	deleteTenantResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteTenantResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteTenantResourceParams[schema.Role]{
 			deleteResourceParams: deleteResourceParams[schema.Role]{
 				resource: resource,
@@ -122,11 +124,12 @@ func (configurator *StepsConfigurator) DeleteRoleV1Step(stepName string, stepCre
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateRoleExpectViolationV1Step(stepName string, api secapi.AuthorizationV1, resource *schema.Role) {
+func (configurator *StepsConfigurator) CreateOrUpdateRoleExpectViolationV1Step(stepName string, api secapi.AuthorizationV1, resource *schema.Role, expected ExpectedError) {
 	violationTenantResourceStep(configurator.t, configurator.suite,
 		actionTenantResourceParams[schema.Role]{
 			actionResourceParams: actionResourceParams[schema.Role]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.Role) error {
 					_, err := api.CreateOrUpdateRole(ctx, r)
 					return err
@@ -145,16 +148,16 @@ func (configurator *StepsConfigurator) CreateOrUpdateRoleAssignmentV1Step(stepNa
 	responseExpects ResponseExpects[schema.GlobalTenantResourceMetadata, schema.RoleAssignmentSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateTenantResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateTenantResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateTenantResourceParams[schema.RoleAssignment, schema.GlobalTenantResourceMetadata, schema.RoleAssignmentSpec, schema.Status]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetAuthorizationV1StepParams,
 			operationName:  constants.CreateOrUpdateRoleAssignmentOperation,
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.RoleAssignment) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.RoleAssignment) (
 				wrappers.ResourceWrapper[schema.RoleAssignment, schema.GlobalTenantResourceMetadata, schema.RoleAssignmentSpec, schema.Status], error,
 			) {
-				resp, err := api.CreateOrUpdateRoleAssignment(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateRoleAssignment(ctx, resource)
 				return wrappers.NewRoleAssignmentWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -165,6 +168,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateRoleAssignmentV1Step(stepNa
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifyRoleAssignmentSpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            RoleAssignmentV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -218,12 +222,13 @@ func (configurator *StepsConfigurator) GetRoleAssignmentV1Step(stepName string,
 }
 
 func (configurator *StepsConfigurator) WatchRoleAssignmentUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.AuthorizationV1, tref secapi.TenantReference) {
-	watchTenantResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchTenantResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchTenantResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.TenantReference]{
-				reference: tref,
+				reference:       tref,
+				ledgerReference: roleAssignmentV1LedgerReference(tref),
 				getErrorFunc: func(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchRoleAssignmentUntilDeleted(configurator.t.Context(), tref, config)
+					return api.WatchRoleAssignmentUntilDeleted(ctx, tref, config)
 				},
 			},
 			stepName:       stepName,
@@ -234,7 +239,7 @@ func (configurator *StepsConfigurator) WatchRoleAssignmentUntilDeletedV1Step(ste
 }
 
 func (configurator *StepsConfigurator) DeleteRoleAssignmentV1Step(stepName string, stepCreator StepCreator, api secapi.AuthorizationV1, resource *schema.RoleAssignment) {
-	deleteTenantResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteTenantResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteTenantResourceParams[schema.RoleAssignment]{
 			deleteResourceParams: deleteResourceParams[schema.RoleAssignment]{
 				resource: resource,
@@ -249,11 +254,12 @@ func (configurator *StepsConfigurator) DeleteRoleAssignmentV1Step(stepName strin
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateRoleAssignmentExpectViolationV1Step(stepName string, api secapi.AuthorizationV1, resource *schema.RoleAssignment) {
+func (configurator *StepsConfigurator) CreateOrUpdateRoleAssignmentExpectViolationV1Step(stepName string, api secapi.AuthorizationV1, resource *schema.RoleAssignment, expected ExpectedError) {
 	violationTenantResourceStep(configurator.t, configurator.suite,
 		actionTenantResourceParams[schema.RoleAssignment]{
 			actionResourceParams: actionResourceParams[schema.RoleAssignment]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.RoleAssignment) error {
 					_, err := api.CreateOrUpdateRoleAssignment(ctx, r)
 					return err
@@ -43,17 +43,17 @@ func (configurator *StepsConfigurator) CreateOrUpdateInstanceV1Step(stepName str
 	responseExpects ResponseExpects[schema.RegionalWorkspaceResourceMetadata, schema.InstanceSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateWorkspaceResourceParams[schema.Instance, schema.RegionalWorkspaceResourceMetadata, schema.InstanceSpec, schema.InstanceStatus]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetComputeV1StepParams,
 			operationName:  constants.CreateOrUpdateInstanceOperation,
 			workspace:      secapi.WorkspaceID(resource.Metadata.Workspace),
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.Instance) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.Instance) (
 				wrappers.ResourceWrapper[schema.Instance, schema.RegionalWorkspaceResourceMetadata, schema.InstanceSpec, schema.InstanceStatus], error,
 			) {
-				resp, err := api.CreateOrUpdateInstance(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateInstance(ctx, resource)
 				return wrappers.NewInstanceWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -64,6 +64,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateInstanceV1Step(stepName str
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifyInstanceSpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            InstanceV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -105,7 +106,7 @@ func (configurator *StepsConfigurator) GetInstanceV1Step(stepName string, api se
 			getValueFunc: func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.ResourceState]) (
 				wrappers.ResourceWrapper[schema.Instance, schema.RegionalWorkspaceResourceMetadata, schema.InstanceSpec, schema.InstanceStatus], error,
 			) {
-				resp, err := api.GetInstanceUntilState(configurator.t.Context(), wref, config)
+				resp, err := api.GetInstanceUntilState(ctx, wref, config)
 				return wrappers.NewInstanceWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -130,7 +131,7 @@ func (configurator *StepsConfigurator) GetInstancePowerStateV1Step(stepName stri
 			getValueFunc: func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverUntilValueConfig[schema.InstanceStatusPowerState]) (
 				wrappers.ResourceWrapper[schema.Instance, schema.RegionalWorkspaceResourceMetadata, schema.InstanceSpec, schema.InstanceStatus], error,
 			) {
-				resp, err := api.GetInstanceUntilPowerState(configurator.t.Context(), wref, config)
+				resp, err := api.GetInstanceUntilPowerState(ctx, wref, config)
 				return wrappers.NewInstanceWrapper(resp), err
 			},
 			expectedMetadata:   responseExpects.Metadata,
@@ -143,12 +144,13 @@ func (configurator *StepsConfigurator) GetInstancePowerStateV1Step(stepName stri
 }
 
 func (configurator *StepsConfigurator) WatchInstanceUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.ComputeV1, wref secapi.WorkspaceReference) {
-	watchWorkspaceResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchWorkspaceResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchWorkspaceResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.WorkspaceReference]{
-				reference: wref,
+				reference:       wref,
+				ledgerReference: instanceV1LedgerReference(wref),
 				getErrorFunc: func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchInstanceUntilDeleted(configurator.t.Context(), wref, config)
+					return api.WatchInstanceUntilDeleted(ctx, wref, config)
 				},
 			},
 			stepName:       stepName,
@@ -209,11 +211,12 @@ func (configurator *StepsConfigurator) RestartInstanceV1Step(stepName string, ap
 	)
 }
 
-func (configurator *StepsConfigurator) InstanceOperationExpectConflictV1Step(stepName string, api secapi.ComputeV1, resource *schema.Instance, operationFunc func(context.Context, *schema.Instance) error, operationName constants.OperationName) {
+func (configurator *StepsConfigurator) InstanceOperationExpectConflictV1Step(stepName string, api secapi.ComputeV1, resource *schema.Instance, operationFunc func(context.Context, *schema.Instance) error, operationName constants.OperationName, expected ExpectedError) {
 	conflictWorkspaceResourceStep(configurator.t, configurator.suite,
 		actionWorkspaceResourceParams[schema.Instance]{
 			actionResourceParams: actionResourceParams[schema.Instance]{
 				resource:   resource,
+				expected:   expected,
 				actionFunc: operationFunc,
 			},
 			stepName:       stepName,
@@ -224,11 +227,12 @@ func (configurator *StepsConfigurator) InstanceOperationExpectConflictV1Step(ste
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateInstanceExpectViolationV1Step(stepName string, api secapi.ComputeV1, resource *schema.Instance) {
+func (configurator *StepsConfigurator) CreateOrUpdateInstanceExpectViolationV1Step(stepName string, api secapi.ComputeV1, resource *schema.Instance, expected ExpectedError) {
 	violationWorkspaceResourceStep(configurator.t, configurator.suite,
 		actionWorkspaceResourceParams[schema.Instance]{
 			actionResourceParams: actionResourceParams[schema.Instance]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.Instance) error {
 					_, err := api.CreateOrUpdateInstance(ctx, r)
 					return err
@@ -243,7 +247,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateInstanceExpectViolationV1St
 }
 
 func (configurator *StepsConfigurator) DeleteInstanceV1Step(stepName string, stepCreator StepCreator, api secapi.ComputeV1, resource *schema.Instance) {
-	deleteWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteWorkspaceResourceParams[schema.Instance]{
 			deleteResourceParams: deleteResourceParams[schema.Instance]{
 				resource: resource,
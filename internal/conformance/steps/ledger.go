@@ -0,0 +1,304 @@
+package steps
+
+import (
+	"context"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
+	"github.com/eu-sovereign-cloud/conformance/pkg/generators"
+	sdkconsts "github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/types"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+// Params
+
+type ledgerEntryParams[R types.ResourceType, F secapi.Reference] struct {
+	kind          string
+	referenceFunc func(F) string
+	resource      *R
+	ref           F
+	deleteFunc    func(context.Context, *R) error
+	watchFunc     func(context.Context, F, secapi.ResourceObserverConfig) error
+}
+
+// Entries
+
+func newLedgerEntry[R types.ResourceType, F secapi.Reference](params ledgerEntryParams[R, F]) suites.LedgerEntry {
+	return suites.LedgerEntry{
+		Kind:      params.kind,
+		Reference: params.referenceFunc(params.ref),
+		DeleteFunc: func(ctx context.Context) error {
+			return params.deleteFunc(ctx, params.resource)
+		},
+		WatchFunc: func(ctx context.Context, config secapi.ResourceObserverConfig) error {
+			return params.watchFunc(ctx, params.ref, config)
+		},
+	}
+}
+
+func RoleV1LedgerEntry(api secapi.AuthorizationV1, resource *schema.Role) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.Role, secapi.TenantReference]{
+		kind:          string(schema.GlobalTenantResourceMetadataKindResourceKindRole),
+		referenceFunc: roleV1LedgerReference,
+		resource:      resource,
+		ref: secapi.TenantReference{
+			Tenant: secapi.TenantID(resource.Metadata.Tenant),
+			Name:   resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteRole,
+		watchFunc:  api.WatchRoleUntilDeleted,
+	})
+}
+
+func RoleAssignmentV1LedgerEntry(api secapi.AuthorizationV1, resource *schema.RoleAssignment) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.RoleAssignment, secapi.TenantReference]{
+		kind:          string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment),
+		referenceFunc: roleAssignmentV1LedgerReference,
+		resource:      resource,
+		ref: secapi.TenantReference{
+			Tenant: secapi.TenantID(resource.Metadata.Tenant),
+			Name:   resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteRoleAssignment,
+		watchFunc:  api.WatchRoleAssignmentUntilDeleted,
+	})
+}
+
+func WorkspaceV1LedgerEntry(api secapi.WorkspaceV1, resource *schema.Workspace) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.Workspace, secapi.TenantReference]{
+		kind:          string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+		referenceFunc: workspaceV1LedgerReference,
+		resource:      resource,
+		ref: secapi.TenantReference{
+			Tenant: secapi.TenantID(resource.Metadata.Tenant),
+			Name:   resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteWorkspace,
+		watchFunc:  api.WatchWorkspaceUntilDeleted,
+	})
+}
+
+func BlockStorageV1LedgerEntry(api secapi.StorageV1, resource *schema.BlockStorage) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.BlockStorage, secapi.WorkspaceReference]{
+		kind:          string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+		referenceFunc: blockStorageV1LedgerReference,
+		resource:      resource,
+		ref: secapi.WorkspaceReference{
+			Tenant:    secapi.TenantID(resource.Metadata.Tenant),
+			Workspace: secapi.WorkspaceID(resource.Metadata.Workspace),
+			Name:      resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteBlockStorage,
+		watchFunc:  api.WatchBlockStorageUntilDeleted,
+	})
+}
+
+func ImageV1LedgerEntry(api secapi.StorageV1, resource *schema.Image) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.Image, secapi.TenantReference]{
+		kind:          string(schema.RegionalResourceMetadataKindResourceKindImage),
+		referenceFunc: imageV1LedgerReference,
+		resource:      resource,
+		ref: secapi.TenantReference{
+			Tenant: secapi.TenantID(resource.Metadata.Tenant),
+			Name:   resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteImage,
+		watchFunc:  api.WatchImageUntilDeleted,
+	})
+}
+
+func InstanceV1LedgerEntry(api secapi.ComputeV1, resource *schema.Instance) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.Instance, secapi.WorkspaceReference]{
+		kind:          string(schema.RegionalResourceMetadataKindResourceKindInstance),
+		referenceFunc: instanceV1LedgerReference,
+		resource:      resource,
+		ref: secapi.WorkspaceReference{
+			Tenant:    secapi.TenantID(resource.Metadata.Tenant),
+			Workspace: secapi.WorkspaceID(resource.Metadata.Workspace),
+			Name:      resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteInstance,
+		watchFunc:  api.WatchInstanceUntilDeleted,
+	})
+}
+
+func NetworkV1LedgerEntry(api secapi.NetworkV1, resource *schema.Network) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.Network, secapi.WorkspaceReference]{
+		kind:          string(schema.RegionalResourceMetadataKindResourceKindNetwork),
+		referenceFunc: networkV1LedgerReference,
+		resource:      resource,
+		ref: secapi.WorkspaceReference{
+			Tenant:    secapi.TenantID(resource.Metadata.Tenant),
+			Workspace: secapi.WorkspaceID(resource.Metadata.Workspace),
+			Name:      resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteNetwork,
+		watchFunc:  api.WatchNetworkUntilDeleted,
+	})
+}
+
+func InternetGatewayV1LedgerEntry(api secapi.NetworkV1, resource *schema.InternetGateway) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.InternetGateway, secapi.WorkspaceReference]{
+		kind:          string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+		referenceFunc: internetGatewayV1LedgerReference,
+		resource:      resource,
+		ref: secapi.WorkspaceReference{
+			Tenant:    secapi.TenantID(resource.Metadata.Tenant),
+			Workspace: secapi.WorkspaceID(resource.Metadata.Workspace),
+			Name:      resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteInternetGateway,
+		watchFunc:  api.WatchInternetGatewayUntilDeleted,
+	})
+}
+
+func RouteTableV1LedgerEntry(api secapi.NetworkV1, resource *schema.RouteTable) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.RouteTable, secapi.NetworkReference]{
+		kind:          string(schema.RegionalResourceMetadataKindResourceKindRoutingTable),
+		referenceFunc: routeTableV1LedgerReference,
+		resource:      resource,
+		ref: secapi.NetworkReference{
+			Tenant:    secapi.TenantID(resource.Metadata.Tenant),
+			Workspace: secapi.WorkspaceID(resource.Metadata.Workspace),
+			Network:   secapi.NetworkID(resource.Metadata.Network),
+			Name:      resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteRouteTable,
+		watchFunc:  api.WatchRouteTableUntilDeleted,
+	})
+}
+
+func SubnetV1LedgerEntry(api secapi.NetworkV1, resource *schema.Subnet) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.Subnet, secapi.NetworkReference]{
+		kind:          string(schema.RegionalResourceMetadataKindResourceKindSubnet),
+		referenceFunc: subnetV1LedgerReference,
+		resource:      resource,
+		ref: secapi.NetworkReference{
+			Tenant:    secapi.TenantID(resource.Metadata.Tenant),
+			Workspace: secapi.WorkspaceID(resource.Metadata.Workspace),
+			Network:   secapi.NetworkID(resource.Metadata.Network),
+			Name:      resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteSubnet,
+		watchFunc:  api.WatchSubnetUntilDeleted,
+	})
+}
+
+func PublicIpV1LedgerEntry(api secapi.NetworkV1, resource *schema.PublicIp) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.PublicIp, secapi.WorkspaceReference]{
+		kind:          string(schema.RegionalResourceMetadataKindResourceKindPublicIP),
+		referenceFunc: publicIpV1LedgerReference,
+		resource:      resource,
+		ref: secapi.WorkspaceReference{
+			Tenant:    secapi.TenantID(resource.Metadata.Tenant),
+			Workspace: secapi.WorkspaceID(resource.Metadata.Workspace),
+			Name:      resource.Metadata.Name,
+		},
+		deleteFunc: api.DeletePublicIp,
+		watchFunc:  api.WatchPublicIpUntilDeleted,
+	})
+}
+
+func NicV1LedgerEntry(api secapi.NetworkV1, resource *schema.Nic) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.Nic, secapi.WorkspaceReference]{
+		kind:          string(schema.RegionalResourceMetadataKindResourceKindNic),
+		referenceFunc: nicV1LedgerReference,
+		resource:      resource,
+		ref: secapi.WorkspaceReference{
+			Tenant:    secapi.TenantID(resource.Metadata.Tenant),
+			Workspace: secapi.WorkspaceID(resource.Metadata.Workspace),
+			Name:      resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteNic,
+		watchFunc:  api.WatchNicUntilDeleted,
+	})
+}
+
+func SecurityGroupRuleV1LedgerEntry(api secapi.NetworkV1, resource *schema.SecurityGroupRule) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.SecurityGroupRule, secapi.WorkspaceReference]{
+		kind:          string(schema.RegionalResourceMetadataKindResourceKindSecurityGroupRule),
+		referenceFunc: securityGroupRuleV1LedgerReference,
+		resource:      resource,
+		ref: secapi.WorkspaceReference{
+			Tenant:    secapi.TenantID(resource.Metadata.Tenant),
+			Workspace: secapi.WorkspaceID(resource.Metadata.Workspace),
+			Name:      resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteSecurityGroupRule,
+		watchFunc:  api.WatchSecurityGroupRuleUntilDeleted,
+	})
+}
+
+func SecurityGroupV1LedgerEntry(api secapi.NetworkV1, resource *schema.SecurityGroup) suites.LedgerEntry {
+	return newLedgerEntry(ledgerEntryParams[schema.SecurityGroup, secapi.WorkspaceReference]{
+		kind:          string(schema.RegionalResourceMetadataKindResourceKindSecurityGroup),
+		referenceFunc: securityGroupV1LedgerReference,
+		resource:      resource,
+		ref: secapi.WorkspaceReference{
+			Tenant:    secapi.TenantID(resource.Metadata.Tenant),
+			Workspace: secapi.WorkspaceID(resource.Metadata.Workspace),
+			Name:      resource.Metadata.Name,
+		},
+		deleteFunc: api.DeleteSecurityGroup,
+		watchFunc:  api.WatchSecurityGroupUntilDeleted,
+	})
+}
+
+// References
+
+func roleV1LedgerReference(ref secapi.TenantReference) string {
+	return generators.GenerateRoleRef(sdkconsts.AuthorizationProviderV1Name, string(ref.Tenant), ref.Name)
+}
+
+func roleAssignmentV1LedgerReference(ref secapi.TenantReference) string {
+	return generators.GenerateRoleAssignmentRef(sdkconsts.AuthorizationProviderV1Name, string(ref.Tenant), ref.Name)
+}
+
+func workspaceV1LedgerReference(ref secapi.TenantReference) string {
+	return generators.GenerateWorkspaceRef(sdkconsts.WorkspaceProviderV1Name, string(ref.Tenant), ref.Name)
+}
+
+func blockStorageV1LedgerReference(ref secapi.WorkspaceReference) string {
+	return generators.GenerateBlockStorageRef(sdkconsts.StorageProviderV1Name, string(ref.Tenant), string(ref.Workspace), ref.Name)
+}
+
+func imageV1LedgerReference(ref secapi.TenantReference) string {
+	return generators.GenerateImageRef(sdkconsts.StorageProviderV1Name, string(ref.Tenant), ref.Name)
+}
+
+func instanceV1LedgerReference(ref secapi.WorkspaceReference) string {
+	return generators.GenerateInstanceRef(sdkconsts.ComputeProviderV1Name, string(ref.Tenant), string(ref.Workspace), ref.Name)
+}
+
+func networkV1LedgerReference(ref secapi.WorkspaceReference) string {
+	return generators.GenerateNetworkRef(sdkconsts.NetworkProviderV1Name, string(ref.Tenant), string(ref.Workspace), ref.Name)
+}
+
+func internetGatewayV1LedgerReference(ref secapi.WorkspaceReference) string {
+	return generators.GenerateInternetGatewayRef(sdkconsts.NetworkProviderV1Name, string(ref.Tenant), string(ref.Workspace), ref.Name)
+}
+
+func routeTableV1LedgerReference(ref secapi.NetworkReference) string {
+	return generators.GenerateRouteTableRef(sdkconsts.NetworkProviderV1Name, string(ref.Tenant), string(ref.Workspace), string(ref.Network), ref.Name)
+}
+
+func subnetV1LedgerReference(ref secapi.NetworkReference) string {
+	return generators.GenerateSubnetRef(sdkconsts.NetworkProviderV1Name, string(ref.Tenant), string(ref.Workspace), string(ref.Network), ref.Name)
+}
+
+func publicIpV1LedgerReference(ref secapi.WorkspaceReference) string {
+	return generators.GeneratePublicIpRef(sdkconsts.NetworkProviderV1Name, string(ref.Tenant), string(ref.Workspace), ref.Name)
+}
+
+func nicV1LedgerReference(ref secapi.WorkspaceReference) string {
+	return generators.GenerateNicRef(sdkconsts.NetworkProviderV1Name, string(ref.Tenant), string(ref.Workspace), ref.Name)
+}
+
+func securityGroupRuleV1LedgerReference(ref secapi.WorkspaceReference) string {
+	return generators.GenerateSecurityGroupRuleRef(sdkconsts.NetworkProviderV1Name, string(ref.Tenant), string(ref.Workspace), ref.Name)
+}
+
+func securityGroupV1LedgerReference(ref secapi.WorkspaceReference) string {
+	return generators.GenerateSecurityGroupRef(sdkconsts.NetworkProviderV1Name, string(ref.Tenant), string(ref.Workspace), ref.Name)
+}
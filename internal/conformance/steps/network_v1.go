@@ -43,17 +43,17 @@ func (configurator *StepsConfigurator) CreateOrUpdateNetworkV1Step(stepName stri
 	responseExpects ResponseExpects[schema.RegionalWorkspaceResourceMetadata, schema.NetworkSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateWorkspaceResourceParams[schema.Network, schema.RegionalWorkspaceResourceMetadata, schema.NetworkSpec, schema.NetworkStatus]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetNetworkV1StepParams,
 			operationName:  constants.CreateOrUpdateNetworkOperation,
 			workspace:      secapi.WorkspaceID(resource.Metadata.Workspace),
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.Network) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.Network) (
 				wrappers.ResourceWrapper[schema.Network, schema.RegionalWorkspaceResourceMetadata, schema.NetworkSpec, schema.NetworkStatus], error,
 			) {
-				resp, err := api.CreateOrUpdateNetwork(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateNetwork(ctx, resource)
 				return wrappers.NewNetworkWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -64,6 +64,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateNetworkV1Step(stepName stri
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifyNetworkSpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            NetworkV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -118,12 +119,13 @@ func (configurator *StepsConfigurator) GetNetworkV1Step(stepName string, api sec
 }
 
 func (configurator *StepsConfigurator) WatchNetworkUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, wref secapi.WorkspaceReference) {
-	watchWorkspaceResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchWorkspaceResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchWorkspaceResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.WorkspaceReference]{
-				reference: wref,
+				reference:       wref,
+				ledgerReference: networkV1LedgerReference(wref),
 				getErrorFunc: func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchNetworkUntilDeleted(configurator.t.Context(), wref, config)
+					return api.WatchNetworkUntilDeleted(ctx, wref, config)
 				},
 			},
 			stepName:       stepName,
@@ -134,7 +136,7 @@ func (configurator *StepsConfigurator) WatchNetworkUntilDeletedV1Step(stepName s
 }
 
 func (configurator *StepsConfigurator) DeleteNetworkV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, resource *schema.Network) {
-	deleteWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteWorkspaceResourceParams[schema.Network]{
 			deleteResourceParams: deleteResourceParams[schema.Network]{
 				resource: resource,
@@ -156,17 +158,17 @@ func (configurator *StepsConfigurator) CreateOrUpdateInternetGatewayV1Step(stepN
 	responseExpects ResponseExpects[schema.RegionalWorkspaceResourceMetadata, schema.InternetGatewaySpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateWorkspaceResourceParams[schema.InternetGateway, schema.RegionalWorkspaceResourceMetadata, schema.InternetGatewaySpec, schema.Status]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetNetworkV1StepParams,
 			operationName:  constants.CreateOrUpdateInternetGatewayOperation,
 			workspace:      secapi.WorkspaceID(resource.Metadata.Workspace),
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.InternetGateway) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.InternetGateway) (
 				wrappers.ResourceWrapper[schema.InternetGateway, schema.RegionalWorkspaceResourceMetadata, schema.InternetGatewaySpec, schema.Status], error,
 			) {
-				resp, err := api.CreateOrUpdateInternetGateway(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateInternetGateway(ctx, resource)
 				return wrappers.NewInternetGatewayWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -177,6 +179,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateInternetGatewayV1Step(stepN
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifyInternetGatewaySpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            InternetGatewayV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -231,12 +234,13 @@ func (configurator *StepsConfigurator) GetInternetGatewayV1Step(stepName string,
 }
 
 func (configurator *StepsConfigurator) WatchInternetGatewayUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, wref secapi.WorkspaceReference) {
-	watchWorkspaceResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchWorkspaceResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchWorkspaceResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.WorkspaceReference]{
-				reference: wref,
+				reference:       wref,
+				ledgerReference: internetGatewayV1LedgerReference(wref),
 				getErrorFunc: func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchInternetGatewayUntilDeleted(configurator.t.Context(), wref, config)
+					return api.WatchInternetGatewayUntilDeleted(ctx, wref, config)
 				},
 			},
 			stepName:       stepName,
@@ -247,7 +251,7 @@ func (configurator *StepsConfigurator) WatchInternetGatewayUntilDeletedV1Step(st
 }
 
 func (configurator *StepsConfigurator) DeleteInternetGatewayV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, resource *schema.InternetGateway) {
-	deleteWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteWorkspaceResourceParams[schema.InternetGateway]{
 			deleteResourceParams: deleteResourceParams[schema.InternetGateway]{
 				resource: resource,
@@ -269,7 +273,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateRouteTableV1Step(stepName s
 	responseExpects ResponseExpects[schema.RegionalNetworkResourceMetadata, schema.RouteTableSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateNetworkResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateNetworkResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateNetworkResourceParams[schema.RouteTable, schema.RegionalNetworkResourceMetadata, schema.RouteTableSpec, schema.RouteTableStatus]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetNetworkNetworkV1StepParams,
@@ -277,10 +281,10 @@ func (configurator *StepsConfigurator) CreateOrUpdateRouteTableV1Step(stepName s
 			workspace:      secapi.WorkspaceID(resource.Metadata.Workspace),
 			network:        secapi.NetworkID(resource.Metadata.Network),
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.RouteTable) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.RouteTable) (
 				wrappers.ResourceWrapper[schema.RouteTable, schema.RegionalNetworkResourceMetadata, schema.RouteTableSpec, schema.RouteTableStatus], error,
 			) {
-				resp, err := api.CreateOrUpdateRouteTable(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateRouteTable(ctx, resource)
 				return wrappers.NewRouteTableWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -291,6 +295,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateRouteTableV1Step(stepName s
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifyRouteTableSpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            RouteTableV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -345,12 +350,13 @@ func (configurator *StepsConfigurator) GetRouteTableV1Step(stepName string, api
 }
 
 func (configurator *StepsConfigurator) WatchRouteTableUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, nref secapi.NetworkReference) {
-	watchNetworkResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchNetworkResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchNetworkResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.NetworkReference]{
-				reference: nref,
+				reference:       nref,
+				ledgerReference: routeTableV1LedgerReference(nref),
 				getErrorFunc: func(ctx context.Context, nref secapi.NetworkReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchRouteTableUntilDeleted(configurator.t.Context(), nref, config)
+					return api.WatchRouteTableUntilDeleted(ctx, nref, config)
 				},
 			},
 			stepName:       stepName,
@@ -361,7 +367,7 @@ func (configurator *StepsConfigurator) WatchRouteTableUntilDeletedV1Step(stepNam
 }
 
 func (configurator *StepsConfigurator) DeleteRouteTableV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, resource *schema.RouteTable) {
-	deleteNetworkResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteNetworkResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteNetworkResourceParams[schema.RouteTable]{
 			deleteResourceParams: deleteResourceParams[schema.RouteTable]{
 				resource: resource,
@@ -378,11 +384,12 @@ func (configurator *StepsConfigurator) DeleteRouteTableV1Step(stepName string, s
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateRouteTableExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.RouteTable) {
+func (configurator *StepsConfigurator) CreateOrUpdateRouteTableExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.RouteTable, expected ExpectedError) {
 	violationNetworkResourceStep(configurator.t, configurator.suite,
 		actionNetworkResourceParams[schema.RouteTable]{
 			actionResourceParams: actionResourceParams[schema.RouteTable]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.RouteTable) error {
 					_, err := api.CreateOrUpdateRouteTable(ctx, r)
 					return err
@@ -403,7 +410,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateSubnetV1Step(stepName strin
 	responseExpects ResponseExpects[schema.RegionalNetworkResourceMetadata, schema.SubnetSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateNetworkResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateNetworkResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateNetworkResourceParams[schema.Subnet, schema.RegionalNetworkResourceMetadata, schema.SubnetSpec, schema.SubnetStatus]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetNetworkNetworkV1StepParams,
@@ -411,10 +418,10 @@ func (configurator *StepsConfigurator) CreateOrUpdateSubnetV1Step(stepName strin
 			workspace:      secapi.WorkspaceID(resource.Metadata.Workspace),
 			network:        secapi.NetworkID(resource.Metadata.Network),
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.Subnet) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.Subnet) (
 				wrappers.ResourceWrapper[schema.Subnet, schema.RegionalNetworkResourceMetadata, schema.SubnetSpec, schema.SubnetStatus], error,
 			) {
-				resp, err := api.CreateOrUpdateSubnet(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateSubnet(ctx, resource)
 				return wrappers.NewSubnetWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -425,6 +432,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateSubnetV1Step(stepName strin
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifySubnetSpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            SubnetV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -479,12 +487,13 @@ func (configurator *StepsConfigurator) GetSubnetV1Step(stepName string, api seca
 }
 
 func (configurator *StepsConfigurator) WatchSubnetUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, nref secapi.NetworkReference) {
-	watchNetworkResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchNetworkResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchNetworkResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.NetworkReference]{
-				reference: nref,
+				reference:       nref,
+				ledgerReference: subnetV1LedgerReference(nref),
 				getErrorFunc: func(ctx context.Context, nref secapi.NetworkReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchSubnetUntilDeleted(configurator.t.Context(), nref, config)
+					return api.WatchSubnetUntilDeleted(ctx, nref, config)
 				},
 			},
 			stepName:       stepName,
@@ -495,7 +504,7 @@ func (configurator *StepsConfigurator) WatchSubnetUntilDeletedV1Step(stepName st
 }
 
 func (configurator *StepsConfigurator) DeleteSubnetV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, resource *schema.Subnet) {
-	deleteNetworkResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteNetworkResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteNetworkResourceParams[schema.Subnet]{
 			deleteResourceParams: deleteResourceParams[schema.Subnet]{
 				resource: resource,
@@ -518,17 +527,17 @@ func (configurator *StepsConfigurator) CreateOrUpdatePublicIpV1Step(stepName str
 	responseExpects ResponseExpects[schema.RegionalWorkspaceResourceMetadata, schema.PublicIpSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateWorkspaceResourceParams[schema.PublicIp, schema.RegionalWorkspaceResourceMetadata, schema.PublicIpSpec, schema.PublicIpStatus]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetNetworkV1StepParams,
 			operationName:  constants.CreateOrUpdatePublicIpOperation,
 			workspace:      secapi.WorkspaceID(resource.Metadata.Workspace),
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.PublicIp) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.PublicIp) (
 				wrappers.ResourceWrapper[schema.PublicIp, schema.RegionalWorkspaceResourceMetadata, schema.PublicIpSpec, schema.PublicIpStatus], error,
 			) {
-				resp, err := api.CreateOrUpdatePublicIp(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdatePublicIp(ctx, resource)
 				return wrappers.NewPublicIpWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -539,6 +548,7 @@ func (configurator *StepsConfigurator) CreateOrUpdatePublicIpV1Step(stepName str
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifyPublicIpSpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            PublicIpV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -593,12 +603,13 @@ func (configurator *StepsConfigurator) GetPublicIpV1Step(stepName string, api se
 }
 
 func (configurator *StepsConfigurator) WatchPublicIpUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, wref secapi.WorkspaceReference) {
-	watchWorkspaceResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchWorkspaceResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchWorkspaceResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.WorkspaceReference]{
-				reference: wref,
+				reference:       wref,
+				ledgerReference: publicIpV1LedgerReference(wref),
 				getErrorFunc: func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchPublicIpUntilDeleted(configurator.t.Context(), wref, config)
+					return api.WatchPublicIpUntilDeleted(ctx, wref, config)
 				},
 			},
 			stepName:       stepName,
@@ -609,7 +620,7 @@ func (configurator *StepsConfigurator) WatchPublicIpUntilDeletedV1Step(stepName
 }
 
 func (configurator *StepsConfigurator) DeletePublicIpV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, resource *schema.PublicIp) {
-	deleteWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteWorkspaceResourceParams[schema.PublicIp]{
 			deleteResourceParams: deleteResourceParams[schema.PublicIp]{
 				resource: resource,
@@ -631,17 +642,17 @@ func (configurator *StepsConfigurator) CreateOrUpdateNicV1Step(stepName string,
 	responseExpects ResponseExpects[schema.RegionalWorkspaceResourceMetadata, schema.NicSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateWorkspaceResourceParams[schema.Nic, schema.RegionalWorkspaceResourceMetadata, schema.NicSpec, schema.NicStatus]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetNetworkV1StepParams,
 			operationName:  constants.CreateOrUpdateNicOperation,
 			workspace:      secapi.WorkspaceID(resource.Metadata.Workspace),
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.Nic) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.Nic) (
 				wrappers.ResourceWrapper[schema.Nic, schema.RegionalWorkspaceResourceMetadata, schema.NicSpec, schema.NicStatus], error,
 			) {
-				resp, err := api.CreateOrUpdateNic(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateNic(ctx, resource)
 				return wrappers.NewNicWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -652,6 +663,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateNicV1Step(stepName string,
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifyNicSpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            NicV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -706,12 +718,13 @@ func (configurator *StepsConfigurator) GetNicV1Step(stepName string, api secapi.
 }
 
 func (configurator *StepsConfigurator) WatchNicUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, wref secapi.WorkspaceReference) {
-	watchWorkspaceResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchWorkspaceResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchWorkspaceResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.WorkspaceReference]{
-				reference: wref,
+				reference:       wref,
+				ledgerReference: nicV1LedgerReference(wref),
 				getErrorFunc: func(ctx context.Context, tref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchNicUntilDeleted(configurator.t.Context(), wref, config)
+					return api.WatchNicUntilDeleted(ctx, wref, config)
 				},
 			},
 			stepName:       stepName,
@@ -722,7 +735,7 @@ func (configurator *StepsConfigurator) WatchNicUntilDeletedV1Step(stepName strin
 }
 
 func (configurator *StepsConfigurator) DeleteNicV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, resource *schema.Nic) {
-	deleteWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteWorkspaceResourceParams[schema.Nic]{
 			deleteResourceParams: deleteResourceParams[schema.Nic]{
 				resource: resource,
@@ -744,17 +757,17 @@ func (configurator *StepsConfigurator) CreateOrUpdateSecurityGroupRuleV1Step(ste
 	responseExpects ResponseExpects[schema.RegionalWorkspaceResourceMetadata, schema.SecurityGroupRuleSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateWorkspaceResourceParams[schema.SecurityGroupRule, schema.RegionalWorkspaceResourceMetadata, schema.SecurityGroupRuleSpec, schema.Status]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetNetworkV1StepParams,
 			operationName:  constants.CreateOrUpdateSecurityGroupRuleOperation,
 			workspace:      secapi.WorkspaceID(resource.Metadata.Workspace),
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.SecurityGroupRule) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.SecurityGroupRule) (
 				wrappers.ResourceWrapper[schema.SecurityGroupRule, schema.RegionalWorkspaceResourceMetadata, schema.SecurityGroupRuleSpec, schema.SecurityGroupRuleStatus], error,
 			) {
-				resp, err := api.CreateOrUpdateSecurityGroupRule(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateSecurityGroupRule(ctx, resource)
 				return wrappers.NewSecurityGroupRuleWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -765,6 +778,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateSecurityGroupRuleV1Step(ste
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifySecurityGroupRuleSpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            SecurityGroupRuleV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -819,12 +833,13 @@ func (configurator *StepsConfigurator) GetSecurityGroupRuleV1Step(stepName strin
 }
 
 func (configurator *StepsConfigurator) WatchSecurityGroupRuleUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, wref secapi.WorkspaceReference) {
-	watchWorkspaceResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchWorkspaceResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchWorkspaceResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.WorkspaceReference]{
-				reference: wref,
+				reference:       wref,
+				ledgerReference: securityGroupRuleV1LedgerReference(wref),
 				getErrorFunc: func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchSecurityGroupRuleUntilDeleted(configurator.t.Context(), wref, config)
+					return api.WatchSecurityGroupRuleUntilDeleted(ctx, wref, config)
 				},
 			},
 			stepName:       stepName,
@@ -835,7 +850,7 @@ func (configurator *StepsConfigurator) WatchSecurityGroupRuleUntilDeletedV1Step(
 }
 
 func (configurator *StepsConfigurator) DeleteSecurityGroupRuleV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, resource *schema.SecurityGroupRule) {
-	deleteWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteWorkspaceResourceParams[schema.SecurityGroupRule]{
 			deleteResourceParams: deleteResourceParams[schema.SecurityGroupRule]{
 				resource: resource,
@@ -857,17 +872,17 @@ func (configurator *StepsConfigurator) CreateOrUpdateSecurityGroupV1Step(stepNam
 	responseExpects ResponseExpects[schema.RegionalWorkspaceResourceMetadata, schema.SecurityGroupSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateWorkspaceResourceParams[schema.SecurityGroup, schema.RegionalWorkspaceResourceMetadata, schema.SecurityGroupSpec, schema.SecurityGroupStatus]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetNetworkV1StepParams,
 			operationName:  constants.CreateOrUpdateSecurityGroupOperation,
 			workspace:      secapi.WorkspaceID(resource.Metadata.Workspace),
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.SecurityGroup) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.SecurityGroup) (
 				wrappers.ResourceWrapper[schema.SecurityGroup, schema.RegionalWorkspaceResourceMetadata, schema.SecurityGroupSpec, schema.SecurityGroupStatus], error,
 			) {
-				resp, err := api.CreateOrUpdateSecurityGroup(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateSecurityGroup(ctx, resource)
 				return wrappers.NewSecurityGroupWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -878,6 +893,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateSecurityGroupV1Step(stepNam
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifySecurityGroupSpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            SecurityGroupV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -932,12 +948,13 @@ func (configurator *StepsConfigurator) GetSecurityGroupV1Step(stepName string, a
 }
 
 func (configurator *StepsConfigurator) WatchSecurityGroupUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, wref secapi.WorkspaceReference) {
-	watchWorkspaceResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchWorkspaceResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchWorkspaceResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.WorkspaceReference]{
-				reference: wref,
+				reference:       wref,
+				ledgerReference: securityGroupV1LedgerReference(wref),
 				getErrorFunc: func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchSecurityGroupUntilDeleted(configurator.t.Context(), wref, config)
+					return api.WatchSecurityGroupUntilDeleted(ctx, wref, config)
 				},
 			},
 			stepName:       stepName,
@@ -948,7 +965,7 @@ func (configurator *StepsConfigurator) WatchSecurityGroupUntilDeletedV1Step(step
 }
 
 func (configurator *StepsConfigurator) DeleteSecurityGroupV1Step(stepName string, stepCreator StepCreator, api secapi.NetworkV1, resource *schema.SecurityGroup) {
-	deleteWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteWorkspaceResourceParams[schema.SecurityGroup]{
 			deleteResourceParams: deleteResourceParams[schema.SecurityGroup]{
 				resource: resource,
@@ -964,11 +981,12 @@ func (configurator *StepsConfigurator) DeleteSecurityGroupV1Step(stepName string
 	)
 }
 
-func (configurator *StepsConfigurator) DeleteNetworkExpectConflictV1Step(stepName string, api secapi.NetworkV1, resource *schema.Network) {
+func (configurator *StepsConfigurator) DeleteNetworkExpectConflictV1Step(stepName string, api secapi.NetworkV1, resource *schema.Network, expected ExpectedError) {
 	conflictWorkspaceResourceStep(configurator.t, configurator.suite,
 		actionWorkspaceResourceParams[schema.Network]{
 			actionResourceParams: actionResourceParams[schema.Network]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.Network) error {
 					return api.DeleteNetwork(ctx, r)
 				},
@@ -981,11 +999,12 @@ func (configurator *StepsConfigurator) DeleteNetworkExpectConflictV1Step(stepNam
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateNetworkExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.Network) {
+func (configurator *StepsConfigurator) CreateOrUpdateNetworkExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.Network, expected ExpectedError) {
 	violationWorkspaceResourceStep(configurator.t, configurator.suite,
 		actionWorkspaceResourceParams[schema.Network]{
 			actionResourceParams: actionResourceParams[schema.Network]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.Network) error {
 					_, err := api.CreateOrUpdateNetwork(ctx, r)
 					return err
@@ -999,11 +1018,12 @@ func (configurator *StepsConfigurator) CreateOrUpdateNetworkExpectViolationV1Ste
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateInternetGatewayExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.InternetGateway) {
+func (configurator *StepsConfigurator) CreateOrUpdateInternetGatewayExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.InternetGateway, expected ExpectedError) {
 	violationWorkspaceResourceStep(configurator.t, configurator.suite,
 		actionWorkspaceResourceParams[schema.InternetGateway]{
 			actionResourceParams: actionResourceParams[schema.InternetGateway]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.InternetGateway) error {
 					_, err := api.CreateOrUpdateInternetGateway(ctx, r)
 					return err
@@ -1017,11 +1037,12 @@ func (configurator *StepsConfigurator) CreateOrUpdateInternetGatewayExpectViolat
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdatePublicIpExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.PublicIp) {
+func (configurator *StepsConfigurator) CreateOrUpdatePublicIpExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.PublicIp, expected ExpectedError) {
 	violationWorkspaceResourceStep(configurator.t, configurator.suite,
 		actionWorkspaceResourceParams[schema.PublicIp]{
 			actionResourceParams: actionResourceParams[schema.PublicIp]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.PublicIp) error {
 					_, err := api.CreateOrUpdatePublicIp(ctx, r)
 					return err
@@ -1035,11 +1056,12 @@ func (configurator *StepsConfigurator) CreateOrUpdatePublicIpExpectViolationV1St
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateNicExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.Nic) {
+func (configurator *StepsConfigurator) CreateOrUpdateNicExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.Nic, expected ExpectedError) {
 	violationWorkspaceResourceStep(configurator.t, configurator.suite,
 		actionWorkspaceResourceParams[schema.Nic]{
 			actionResourceParams: actionResourceParams[schema.Nic]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.Nic) error {
 					_, err := api.CreateOrUpdateNic(ctx, r)
 					return err
@@ -1053,11 +1075,12 @@ func (configurator *StepsConfigurator) CreateOrUpdateNicExpectViolationV1Step(st
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateSecurityGroupExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.SecurityGroup) {
+func (configurator *StepsConfigurator) CreateOrUpdateSecurityGroupExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.SecurityGroup, expected ExpectedError) {
 	violationWorkspaceResourceStep(configurator.t, configurator.suite,
 		actionWorkspaceResourceParams[schema.SecurityGroup]{
 			actionResourceParams: actionResourceParams[schema.SecurityGroup]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.SecurityGroup) error {
 					_, err := api.CreateOrUpdateSecurityGroup(ctx, r)
 					return err
@@ -1071,11 +1094,12 @@ func (configurator *StepsConfigurator) CreateOrUpdateSecurityGroupExpectViolatio
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.SecurityGroupRule) {
+func (configurator *StepsConfigurator) CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.SecurityGroupRule, expected ExpectedError) {
 	violationWorkspaceResourceStep(configurator.t, configurator.suite,
 		actionWorkspaceResourceParams[schema.SecurityGroupRule]{
 			actionResourceParams: actionResourceParams[schema.SecurityGroupRule]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.SecurityGroupRule) error {
 					_, err := api.CreateOrUpdateSecurityGroupRule(ctx, r)
 					return err
@@ -1089,11 +1113,12 @@ func (configurator *StepsConfigurator) CreateOrUpdateSecurityGroupRuleExpectViol
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateSubnetExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.Subnet) {
+func (configurator *StepsConfigurator) CreateOrUpdateSubnetExpectViolationV1Step(stepName string, api secapi.NetworkV1, resource *schema.Subnet, expected ExpectedError) {
 	violationNetworkResourceStep(configurator.t, configurator.suite,
 		actionNetworkResourceParams[schema.Subnet]{
 			actionResourceParams: actionResourceParams[schema.Subnet]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.Subnet) error {
 					_, err := api.CreateOrUpdateSubnet(ctx, r)
 					return err
@@ -16,7 +16,7 @@ func (configurator *StepsConfigurator) GetRegionV1Step(stepName string, ctx cont
 	responseExpects ResponseExpects[schema.GlobalResourceMetadata, schema.RegionSpec],
 ) *schema.Region {
 	responseExpects.Metadata.Verb = http.MethodGet
-	return getGlobalResourceStep(configurator.t,
+	return getGlobalResourceStep(configurator.t, configurator.suite,
 		getGlobalResourceParams[schema.Region, schema.GlobalResourceMetadata, schema.RegionSpec]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetRegionV1StepParams,
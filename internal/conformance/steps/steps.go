@@ -1,6 +1,8 @@
 package steps
 
 import (
+	"net/http"
+
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/types"
 
@@ -26,6 +28,35 @@ type ResponseExpectsWithCondition[M types.MetadataType, E types.SpecType, S type
 	ResourceStatus S
 }
 
+// ExpectedError is the problem details response a rejected request must return
+type ExpectedError struct {
+	StatusCode int
+	Type       schema.ErrorType
+	// Pointer is the JSON pointer of the rejected request field, matched against the error sources when set
+	Pointer string
+}
+
+// ValidationError expects the request to be rejected as invalid, with 422 Unprocessable Entity
+func ValidationError() ExpectedError {
+	return ExpectedError{StatusCode: http.StatusUnprocessableEntity, Type: schema.ErrorTypeValidationError}
+}
+
+// ResourceConflictError expects the request to be rejected as conflicting with the resource state, with 409 Conflict
+func ResourceConflictError() ExpectedError {
+	return ExpectedError{StatusCode: http.StatusConflict, Type: schema.ErrorTypeResourceConflict}
+}
+
+// ResourceNotFoundError expects the request to be rejected on a missing resource, with 404 Not Found
+func ResourceNotFoundError() ExpectedError {
+	return ExpectedError{StatusCode: http.StatusNotFound, Type: schema.ErrorTypeResourceNotFound}
+}
+
+// At expects the error to point at the given request field, e.g. /spec/cidr/ipv4
+func (expected ExpectedError) At(pointer string) ExpectedError {
+	expected.Pointer = pointer
+	return expected
+}
+
 type StepCreator interface {
 	WithNewStep(stepName string, step func(sCtx provider.StepCtx), params ...*allure.Parameter)
 }
@@ -2,9 +2,9 @@ package steps
 
 import (
 	"context"
-	"fmt"
-	"log/slog"
 
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/progress"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/constants"
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/types"
@@ -26,6 +26,8 @@ type actionWorkspaceResourceParams[R types.ResourceType] struct {
 type actionResourceParams[R types.ResourceType] struct {
 	resource   *R
 	actionFunc func(context.Context, *R) error
+	// expected is the error response of the violation and conflict steps
+	expected ExpectedError
 }
 
 type actionTenantResourceParams[R types.ResourceType] struct {
@@ -54,11 +56,16 @@ func actionWorkspaceResourceStep[R types.ResourceType](t provider.T, suite *suit
 }
 
 func actionResourceStep[R types.ResourceType](t provider.T, suite *suites.TestSuite, stepName string, sCtx provider.StepCtx, params actionResourceParams[R]) {
-	slog.Info(fmt.Sprintf("[%s] %s", suite.ScenarioName, stepName))
+	logger := suite.StepLogger(sCtx, stepName)
+	logger.Info("Running step")
+	progress.StepStarted(suite.ScenarioName, stepName)
 
 	resourceRequestStep(sCtx, params.resource)
-	err := params.actionFunc(t.Context(), params.resource)
+	calls := har.NewRecorder()
+	err := params.actionFunc(har.WithRecorder(suite.Context(t), calls), params.resource)
 	emptyResponseStep(sCtx)
+	suite.VerifyResponsesStep(t.Context(), sCtx)
+	suite.VerifyTimingsStep(sCtx, calls.Since(0), false)
 
 	requireNoError(sCtx, err)
 }
@@ -66,54 +73,54 @@ func actionResourceStep[R types.ResourceType](t provider.T, suite *suites.TestSu
 func violationWorkspaceResourceStep[R types.ResourceType](t provider.T, suite *suites.TestSuite, params actionWorkspaceResourceParams[R]) {
 	t.WithNewStep(params.stepName, func(sCtx provider.StepCtx) {
 		params.stepParamsFunc(sCtx, params.operationName, params.workspace)
-		violationResourceStep(t, suite, params.stepName, sCtx, params.actionResourceParams)
+		rejectedResourceStep(t, suite, params.stepName, sCtx, params.actionResourceParams)
 	})
 }
 
-func violationResourceStep[R types.ResourceType](t provider.T, suite *suites.TestSuite, stepName string, sCtx provider.StepCtx, params actionResourceParams[R]) {
-	slog.Info(fmt.Sprintf("[%s] %s", suite.ScenarioName, stepName))
-
-	resourceRequestStep(sCtx, params.resource)
-	err := params.actionFunc(t.Context(), params.resource)
-	emptyResponseStep(sCtx)
-
-	requirePreConditionFailedError(sCtx, err)
-}
-
 func violationTenantResourceStep[R types.ResourceType](t provider.T, suite *suites.TestSuite, params actionTenantResourceParams[R]) {
 	t.WithNewStep(params.stepName, func(sCtx provider.StepCtx) {
 		params.stepParamsFunc(sCtx, params.operationName)
-		violationResourceStep(t, suite, params.stepName, sCtx, params.actionResourceParams)
+		rejectedResourceStep(t, suite, params.stepName, sCtx, params.actionResourceParams)
 	})
 }
 
 func violationNetworkResourceStep[R types.ResourceType](t provider.T, suite *suites.TestSuite, params actionNetworkResourceParams[R]) {
 	t.WithNewStep(params.stepName, func(sCtx provider.StepCtx) {
 		params.stepParamsFunc(sCtx, params.operationName, params.workspace, params.network)
-		violationResourceStep(t, suite, params.stepName, sCtx, params.actionResourceParams)
+		rejectedResourceStep(t, suite, params.stepName, sCtx, params.actionResourceParams)
 	})
 }
 
 func conflictTenantResourceStep[R types.ResourceType](t provider.T, suite *suites.TestSuite, params actionTenantResourceParams[R]) {
 	t.WithNewStep(params.stepName, func(sCtx provider.StepCtx) {
 		params.stepParamsFunc(sCtx, params.operationName)
-		conflictResourceStep(t, suite, params.stepName, sCtx, params.actionResourceParams)
+		rejectedResourceStep(t, suite, params.stepName, sCtx, params.actionResourceParams)
 	})
 }
 
 func conflictWorkspaceResourceStep[R types.ResourceType](t provider.T, suite *suites.TestSuite, params actionWorkspaceResourceParams[R]) {
 	t.WithNewStep(params.stepName, func(sCtx provider.StepCtx) {
 		params.stepParamsFunc(sCtx, params.operationName, params.workspace)
-		conflictResourceStep(t, suite, params.stepName, sCtx, params.actionResourceParams)
+		rejectedResourceStep(t, suite, params.stepName, sCtx, params.actionResourceParams)
 	})
 }
 
-func conflictResourceStep[R types.ResourceType](t provider.T, suite *suites.TestSuite, stepName string, sCtx provider.StepCtx, params actionResourceParams[R]) {
-	slog.Info(fmt.Sprintf("[%s] %s", suite.ScenarioName, stepName))
+func rejectedResourceStep[R types.ResourceType](t provider.T, suite *suites.TestSuite, stepName string, sCtx provider.StepCtx, params actionResourceParams[R]) {
+	logger := suite.StepLogger(sCtx, stepName)
+	logger.Info("Running step")
+	progress.StepStarted(suite.ScenarioName, stepName)
 
 	resourceRequestStep(sCtx, params.resource)
-	err := params.actionFunc(t.Context(), params.resource)
-	emptyResponseStep(sCtx)
-
-	requirePreConditionFailedError(sCtx, err)
+	exchanges := har.NewRecorder()
+	err := params.actionFunc(har.WithRecorder(suite.Context(t), exchanges), params.resource)
+
+	var response *har.Response
+	if entry, found := exchanges.Last(); found && entry.Error == "" {
+		response = &entry.Response
+	}
+	errorResponseStep(sCtx, response)
+	suite.VerifyResponsesStep(t.Context(), sCtx)
+	suite.VerifyTimingsStep(sCtx, exchanges.Since(0), false)
+
+	requireExpectedError(sCtx, err, response, params.expected)
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"log/slog"
 
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/types"
 	"github.com/eu-sovereign-cloud/go-sdk/secapi"
@@ -93,3 +94,13 @@ func resourcesResponseStep[R types.ResourceType](ctx provider.StepCtx, metadata
 func emptyResponseStep(ctx provider.StepCtx) {
 	ctx.WithNewStep("Receive response", func(stepCtx provider.StepCtx) {})
 }
+
+// errorResponseStep shows the status and body of the response, read from the exchange since the go-sdk only returns an error
+func errorResponseStep(ctx provider.StepCtx, response *har.Response) {
+	ctx.WithNewStep("Receive response", func(stepCtx provider.StepCtx) {
+		if response == nil {
+			return
+		}
+		stepCtx.WithNewParameters("status", response.Status, "body", response.Content.Text)
+	})
+}
@@ -2,9 +2,9 @@ package steps
 
 import (
 	"context"
-	"fmt"
-	"log/slog"
 
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/progress"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/constants"
 	"github.com/eu-sovereign-cloud/conformance/pkg/wrappers"
@@ -31,6 +31,7 @@ type createOrUpdateTenantResourceParams[R types.ResourceType, M types.MetadataTy
 	expectedSpec           *E
 	verifySpecFunc         func(provider.StepCtx, *E, *E)
 	expectedResourceStates []schema.ResourceState
+	ledgerEntry            suites.LedgerEntry
 }
 
 type createOrUpdateWorkspaceResourceParams[R types.ResourceType, M types.MetadataType, E types.SpecType, S types.StatusType] struct {
@@ -48,6 +49,7 @@ type createOrUpdateWorkspaceResourceParams[R types.ResourceType, M types.Metadat
 	expectedSpec           *E
 	verifySpecFunc         func(provider.StepCtx, *E, *E)
 	expectedResourceStates []schema.ResourceState
+	ledgerEntry            suites.LedgerEntry
 }
 
 type createOrUpdateNetworkResourceParams[R types.ResourceType, M types.MetadataType, E types.SpecType, S types.StatusType] struct {
@@ -66,6 +68,7 @@ type createOrUpdateNetworkResourceParams[R types.ResourceType, M types.MetadataT
 	expectedSpec           *E
 	verifySpecFunc         func(provider.StepCtx, *E, *E)
 	expectedResourceStates []schema.ResourceState
+	ledgerEntry            suites.LedgerEntry
 }
 
 type createOrUpdateResourceParams[R types.ResourceType, M types.MetadataType, E types.SpecType, S types.StatusType] struct {
@@ -79,6 +82,7 @@ type createOrUpdateResourceParams[R types.ResourceType, M types.MetadataType, E
 	expectedSpec           *E
 	verifySpecFunc         func(provider.StepCtx, *E, *E)
 	expectedResourceStates []schema.ResourceState
+	ledgerEntry            suites.LedgerEntry
 }
 
 // Steps
@@ -100,6 +104,7 @@ func createOrUpdateTenantResourceStep[R types.ResourceType, M types.MetadataType
 			expectedSpec:           params.expectedSpec,
 			verifySpecFunc:         params.verifySpecFunc,
 			expectedResourceStates: params.expectedResourceStates,
+			ledgerEntry:            params.ledgerEntry,
 		})
 	})
 }
@@ -121,6 +126,7 @@ func createOrUpdateWorkspaceResourceStep[R types.ResourceType, M types.MetadataT
 			expectedSpec:           params.expectedSpec,
 			verifySpecFunc:         params.verifySpecFunc,
 			expectedResourceStates: params.expectedResourceStates,
+			ledgerEntry:            params.ledgerEntry,
 		})
 	})
 }
@@ -142,6 +148,7 @@ func createOrUpdateNetworkResourceStep[R types.ResourceType, M types.MetadataTyp
 			expectedSpec:           params.expectedSpec,
 			verifySpecFunc:         params.verifySpecFunc,
 			expectedResourceStates: params.expectedResourceStates,
+			ledgerEntry:            params.ledgerEntry,
 		})
 	})
 }
@@ -149,11 +156,19 @@ func createOrUpdateNetworkResourceStep[R types.ResourceType, M types.MetadataTyp
 func createOrUpdateResourceStep[R types.ResourceType, M types.MetadataType, E types.SpecType, S types.StatusType](
 	ctx context.Context, suite *suites.TestSuite, stepName string, sCtx provider.StepCtx, params createOrUpdateResourceParams[R, M, E, S],
 ) {
-	slog.Info(fmt.Sprintf("[%s] %s", suite.ScenarioName, stepName))
+	logger := suite.StepLogger(sCtx, stepName)
+	logger.Info("Running step")
+	progress.StepStarted(suite.ScenarioName, stepName)
 
 	resourceRequestStep(sCtx, params.resource)
 
-	resp, err := params.createOrUpdateFunc(ctx, params.resource)
+	calls := har.NewRecorder()
+	resp, err := params.createOrUpdateFunc(har.WithRecorder(ctx, calls), params.resource)
+	suite.VerifyResponsesStep(ctx, sCtx)
+	suite.VerifyTimingsStep(sCtx, calls.Since(0), false)
+	if err == nil {
+		suite.Ledger.Register(params.ledgerEntry)
+	}
 	requireNoError(sCtx, err)
 	requireNotNilResponse(sCtx, resp)
 
@@ -178,7 +193,7 @@ func createOrUpdateResourceStep[R types.ResourceType, M types.MetadataType, E ty
 	if resp.GetMetadata() != nil && params.expectedMetadata != nil {
 		params.verifyMetadataFunc(sCtx, params.expectedMetadata, resp.GetMetadata())
 	} else {
-		slog.Error("Metadata verification failed: expected or actual metadata is nil")
+		logger.Error("Metadata verification failed: expected or actual metadata is nil")
 		return
 	}
 
@@ -190,7 +205,7 @@ func createOrUpdateResourceStep[R types.ResourceType, M types.MetadataType, E ty
 	if resp.GetStatus() != nil && len(params.expectedResourceStates) > 0 {
 		suite.VerifyStatusStatesStep(sCtx, params.expectedResourceStates, types.GetStatusState(resp.GetStatus()))
 	} else {
-		slog.Error("Status verification failed: expected or actual Status is nil")
+		logger.Error("Status verification failed: expected or actual Status is nil")
 		return
 	}
 }
@@ -2,9 +2,9 @@ package steps
 
 import (
 	"context"
-	"fmt"
-	"log/slog"
 
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/progress"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/constants"
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/types"
@@ -68,11 +68,16 @@ func deleteNetworkResourceStep[R types.ResourceType](ctx context.Context, suite
 }
 
 func deleteResourceStep[R types.ResourceType](ctx context.Context, suite *suites.TestSuite, stepName string, sCtx provider.StepCtx, params deleteResourceParams[R]) {
-	slog.Info(fmt.Sprintf("[%s] %s", suite.ScenarioName, stepName))
+	logger := suite.StepLogger(sCtx, stepName)
+	logger.Info("Running step")
+	progress.StepStarted(suite.ScenarioName, stepName)
 
 	resourceRequestStep(sCtx, params.resource)
-	err := params.deleteFunc(ctx, params.resource)
+	calls := har.NewRecorder()
+	err := params.deleteFunc(har.WithRecorder(ctx, calls), params.resource)
 	emptyResponseStep(sCtx)
+	suite.VerifyResponsesStep(ctx, sCtx)
+	suite.VerifyTimingsStep(sCtx, calls.Since(0), false)
 
 	requireNoError(sCtx, err)
 }
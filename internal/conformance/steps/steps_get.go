@@ -2,10 +2,11 @@ package steps
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
 	"time"
 
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/progress"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/constants"
 	"github.com/eu-sovereign-cloud/conformance/pkg/wrappers"
@@ -102,7 +103,7 @@ type getResourceUntilValueParams[R types.ResourceType, M types.MetadataType, E t
 // Steps
 
 func getGlobalResourceStep[R types.ResourceType, M types.MetadataType, E types.SpecType](
-	t provider.T,
+	t provider.T, suite *suites.TestSuite,
 	params getGlobalResourceParams[R, M, E],
 ) *R {
 	var err error
@@ -111,7 +112,10 @@ func getGlobalResourceStep[R types.ResourceType, M types.MetadataType, E types.S
 		params.stepParamsFunc(sCtx, params.operationName)
 
 		emptyRequestStep(sCtx)
-		resp, err = params.getFunc(t.Context(), params.resourceName)
+		calls := har.NewRecorder()
+		resp, err = params.getFunc(har.WithRecorder(suite.Context(t), calls), params.resourceName)
+		suite.VerifyResponsesStep(t.Context(), sCtx)
+		suite.VerifyTimingsStep(sCtx, calls.Since(0), false)
 
 		requireNoError(sCtx, err)
 		requireNotNilResponse(sCtx, resp)
@@ -236,7 +240,9 @@ func getNetworkResourceStep[R types.ResourceType, M types.MetadataType, E types.
 func getResourceUntilValueStep[R types.ResourceType, M types.MetadataType, E types.SpecType, S types.StatusType, F secapi.Reference, V any](
 	t provider.T, suite *suites.TestSuite, stepName string, sCtx provider.StepCtx, params getResourceUntilValueParams[R, M, E, S, F, V],
 ) *R {
-	slog.Info(fmt.Sprintf("[%s] %s", suite.ScenarioName, stepName))
+	logger := suite.StepLogger(sCtx, stepName)
+	logger.Info("Running step")
+	progress.StepStarted(suite.ScenarioName, stepName)
 
 	config := secapi.ResourceObserverUntilValueConfig[V]{
 		ExpectedValues: params.observerExpectedValues,
@@ -246,7 +252,17 @@ func getResourceUntilValueStep[R types.ResourceType, M types.MetadataType, E typ
 	}
 	referenceRequestStep(sCtx, params.reference)
 
-	resp, err := params.getValueFunc(t.Context(), params.reference, config)
+	polls := har.NewRecorder()
+	ctx := har.WithRecorder(progress.ObserveAttempts(suite.Context(t), suite.ScenarioName, config.MaxAttempts), polls)
+	resp, err := params.getValueFunc(ctx, params.reference, config)
+	suite.VerifyResponsesStep(ctx, sCtx)
+
+	// Timeline, also when the expected value was never observed
+	snapshots := observedSnapshots(polls.Since(0))
+	timelineAttachmentStep(sCtx, snapshots)
+	suite.VerifyStateTransitionsStep(sCtx, snapshots)
+	suite.VerifyTimingsStep(sCtx, polls.Since(0), err == nil)
+
 	requireNoError(sCtx, err)
 	requireNotNilResponse(sCtx, resp)
 
@@ -261,7 +277,7 @@ func getResourceUntilValueStep[R types.ResourceType, M types.MetadataType, E typ
 	if params.expectedMetadata != nil {
 		params.verifyMetadataFunc(sCtx, params.expectedMetadata, resp.GetMetadata())
 	} else {
-		slog.Error("Metadata verification failed: expected or actual metadata is nil")
+		logger.Error("Metadata verification failed: expected or actual metadata is nil")
 		t.Fail()
 	}
 
@@ -274,7 +290,7 @@ func getResourceUntilValueStep[R types.ResourceType, M types.MetadataType, E typ
 	if expectedState != "" {
 		suite.VerifyStatusStateStep(sCtx, expectedState, types.GetStatusState(resp.GetStatus()))
 	} else {
-		slog.Error("Status verification failed: expected or actual Status is nil")
+		logger.Error("Status verification failed: expected or actual Status is nil")
 		t.Fail()
 	}
 
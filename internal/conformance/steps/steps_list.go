@@ -2,9 +2,11 @@ package steps
 
 import (
 	"context"
-	"fmt"
-	"log/slog"
+	"errors"
+	"io"
 
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/progress"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/constants"
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
@@ -72,15 +74,24 @@ func listGlobalResourcesStep[R types.ResourceType, M types.MetadataType, E types
 ) []*R {
 	var items []*R
 	t.WithNewStep(params.stepName, func(sCtx provider.StepCtx) {
-		slog.Info(fmt.Sprintf("[%s] %s", suite.ScenarioName, stepName))
+		params.stepParamsFunc(sCtx, params.operationName)
+
+		logger := suite.StepLogger(sCtx, stepName)
+		logger.Info("Running step")
+		progress.StepStarted(suite.ScenarioName, stepName)
 
 		emptyRequestStep(sCtx)
 
-		resp, err := params.listFunc(t.Context(), params.listOptions)
+		calls := har.NewRecorder()
+		ctx := har.WithRecorder(suite.Context(t), calls)
+		resp, err := params.listFunc(ctx, params.listOptions)
+		suite.VerifyResponsesStep(t.Context(), sCtx)
 		requireNoError(sCtx, err)
 		requireNotNilResponse(sCtx, resp)
 
-		items, err = resp.All(t.Context())
+		items, err = allItems(ctx, resp)
+		suite.VerifyResponsesStep(t.Context(), sCtx)
+		suite.VerifyTimingsStep(sCtx, calls.Since(0), false)
 		requireNoError(sCtx, err)
 		requireNotNilResponse(sCtx, items)
 		requireNotEmptyResponse(sCtx, items)
@@ -133,15 +144,22 @@ func listNetworkResourcesStep[R types.ResourceType, M types.MetadataType, E type
 func listResourcesStep[R types.ResourceType, M types.MetadataType, E types.SpecType, P secapi.PathType](
 	t provider.T, suite *suites.TestSuite, stepName string, sCtx provider.StepCtx, params listResourcesParams[R, M, E, P],
 ) []*R {
-	slog.Info(fmt.Sprintf("[%s] %s", suite.ScenarioName, stepName))
+	logger := suite.StepLogger(sCtx, stepName)
+	logger.Info("Running step")
+	progress.StepStarted(suite.ScenarioName, stepName)
 
 	pathRequestStep(sCtx, params.path, params.listOptions)
 
-	resp, err := params.listFunc(t.Context(), params.path, params.listOptions)
+	calls := har.NewRecorder()
+	ctx := har.WithRecorder(suite.Context(t), calls)
+	resp, err := params.listFunc(ctx, params.path, params.listOptions)
+	suite.VerifyResponsesStep(t.Context(), sCtx)
 	requireNoError(sCtx, err)
 	requireNotNilResponse(sCtx, resp)
 
-	items, err := resp.All(t.Context())
+	items, err := allItems(ctx, resp)
+	suite.VerifyResponsesStep(t.Context(), sCtx)
+	suite.VerifyTimingsStep(sCtx, calls.Since(0), false)
 	requireNoError(sCtx, err)
 	requireNotNilResponse(sCtx, items)
 	requireNotEmptyResponse(sCtx, items)
@@ -158,3 +176,18 @@ func listResourcesStep[R types.ResourceType, M types.MetadataType, E types.SpecT
 	}
 	return items
 }
+
+// allItems reads every page with the given context, which the go-sdk iterator All drops
+func allItems[R types.ResourceType](ctx context.Context, iterator *secapi.Iterator[R]) ([]*R, error) {
+	var items []*R
+	for {
+		item, err := iterator.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return items, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+}
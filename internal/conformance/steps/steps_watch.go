@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/progress"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
 	"github.com/eu-sovereign-cloud/conformance/internal/constants"
 	"github.com/eu-sovereign-cloud/go-sdk/secapi"
@@ -35,8 +37,9 @@ type watchNetworkResourceUntilDeletedParams struct {
 }
 
 type watchResourceUntilDeletedParams[F secapi.Reference] struct {
-	reference    F
-	getErrorFunc func(context.Context, F, secapi.ResourceObserverConfig) error
+	reference       F
+	getErrorFunc    func(context.Context, F, secapi.ResourceObserverConfig) error
+	ledgerReference string
 }
 
 // Steps
@@ -46,7 +49,7 @@ func watchTenantResourceUntilDeletedStep(
 ) {
 	stepCreator.WithNewStep(params.stepName, func(sCtx provider.StepCtx) {
 		params.stepParamsFunc(sCtx, params.operationName)
-		watchResourceUntilDeletedStep(ctx, suite, sCtx, params.watchResourceUntilDeletedParams)
+		watchResourceUntilDeletedStep(ctx, suite, params.stepName, sCtx, params.watchResourceUntilDeletedParams)
 	})
 }
 
@@ -55,7 +58,7 @@ func watchWorkspaceResourceUntilDeletedStep(
 ) {
 	stepCreator.WithNewStep(params.stepName, func(sCtx provider.StepCtx) {
 		params.stepParamsFunc(sCtx, params.operationName, params.reference.Workspace)
-		watchResourceUntilDeletedStep(ctx, suite, sCtx, params.watchResourceUntilDeletedParams)
+		watchResourceUntilDeletedStep(ctx, suite, params.stepName, sCtx, params.watchResourceUntilDeletedParams)
 	})
 }
 
@@ -64,13 +67,16 @@ func watchNetworkResourceUntilDeletedStep(
 ) {
 	stepCreator.WithNewStep(params.stepName, func(sCtx provider.StepCtx) {
 		params.stepParamsFunc(sCtx, params.operationName, params.reference.Workspace, params.reference.Network)
-		watchResourceUntilDeletedStep(ctx, suite, sCtx, params.watchResourceUntilDeletedParams)
+		watchResourceUntilDeletedStep(ctx, suite, params.stepName, sCtx, params.watchResourceUntilDeletedParams)
 	})
 }
 
 func watchResourceUntilDeletedStep[F secapi.Reference](
-	tctx context.Context, suite *suites.TestSuite, sCtx provider.StepCtx, params watchResourceUntilDeletedParams[F],
+	tctx context.Context, suite *suites.TestSuite, stepName string, sCtx provider.StepCtx, params watchResourceUntilDeletedParams[F],
 ) {
+	suite.StepLogger(sCtx, stepName).Info("Running step")
+	progress.StepStarted(suite.ScenarioName, stepName)
+
 	config := secapi.ResourceObserverConfig{
 		Delay:       time.Duration(suite.BaseDelay) * time.Second,
 		Interval:    time.Duration(suite.BaseInterval) * time.Second,
@@ -78,6 +84,20 @@ func watchResourceUntilDeletedStep[F secapi.Reference](
 	}
 	referenceRequestStep(sCtx, params.reference)
 
-	err := params.getErrorFunc(tctx, params.reference, config)
+	polls := har.NewRecorder()
+	ctx := har.WithRecorder(progress.ObserveAttempts(tctx, suite.ScenarioName, config.MaxAttempts), polls)
+	err := params.getErrorFunc(ctx, params.reference, config)
+	suite.VerifyResponsesStep(ctx, sCtx)
+
+	// Timeline, also when the resource was never deleted
+	snapshots := observedSnapshots(polls.Since(0))
+	timelineAttachmentStep(sCtx, snapshots)
+	suite.VerifyStateTransitionsStep(sCtx, snapshots)
+	suite.VerifyTimingsStep(sCtx, polls.Since(0), err == nil)
+
+	// Only a resource seen deleted leaves the ledger, the cleanup retries the ones stuck after their delete
+	if err == nil {
+		suite.Ledger.Release(params.ledgerReference)
+	}
 	requireNoError(sCtx, err)
 }
@@ -18,17 +18,17 @@ func (configurator *StepsConfigurator) CreateOrUpdateBlockStorageV1Step(stepName
 	responseExpects ResponseExpects[schema.RegionalWorkspaceResourceMetadata, schema.BlockStorageSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateWorkspaceResourceParams[schema.BlockStorage, schema.RegionalWorkspaceResourceMetadata, schema.BlockStorageSpec, schema.BlockStorageStatus]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetStorageWorkspaceV1StepParams,
 			operationName:  constants.CreateOrUpdateBlockStorageOperation,
 			workspace:      secapi.WorkspaceID(resource.Metadata.Workspace),
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.BlockStorage) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.BlockStorage) (
 				wrappers.ResourceWrapper[schema.BlockStorage, schema.RegionalWorkspaceResourceMetadata, schema.BlockStorageSpec, schema.BlockStorageStatus], error,
 			) {
-				resp, err := api.CreateOrUpdateBlockStorage(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateBlockStorage(ctx, resource)
 				return wrappers.NewBlockStorageWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -39,6 +39,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateBlockStorageV1Step(stepName
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifyBlockStorageSpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            BlockStorageV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -118,12 +119,13 @@ func (configurator *StepsConfigurator) GetBlockStorageV1Step(stepName string, ap
 }
 
 func (configurator *StepsConfigurator) WatchBlockStorageUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.StorageV1, wref secapi.WorkspaceReference) {
-	watchWorkspaceResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchWorkspaceResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchWorkspaceResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.WorkspaceReference]{
-				reference: wref,
+				reference:       wref,
+				ledgerReference: blockStorageV1LedgerReference(wref),
 				getErrorFunc: func(ctx context.Context, wref secapi.WorkspaceReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchBlockStorageUntilDeleted(configurator.t.Context(), wref, config)
+					return api.WatchBlockStorageUntilDeleted(ctx, wref, config)
 				},
 			},
 			stepName:       stepName,
@@ -134,7 +136,7 @@ func (configurator *StepsConfigurator) WatchBlockStorageUntilDeletedV1Step(stepN
 }
 
 func (configurator *StepsConfigurator) DeleteBlockStorageV1Step(stepName string, stepCreator StepCreator, api secapi.StorageV1, resource *schema.BlockStorage) {
-	deleteWorkspaceResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteWorkspaceResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteWorkspaceResourceParams[schema.BlockStorage]{
 			deleteResourceParams: deleteResourceParams[schema.BlockStorage]{
 				resource: resource,
@@ -150,11 +152,12 @@ func (configurator *StepsConfigurator) DeleteBlockStorageV1Step(stepName string,
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateBlockStorageExpectViolationV1Step(stepName string, api secapi.StorageV1, resource *schema.BlockStorage) {
+func (configurator *StepsConfigurator) CreateOrUpdateBlockStorageExpectViolationV1Step(stepName string, api secapi.StorageV1, resource *schema.BlockStorage, expected ExpectedError) {
 	violationWorkspaceResourceStep(configurator.t, configurator.suite,
 		actionWorkspaceResourceParams[schema.BlockStorage]{
 			actionResourceParams: actionResourceParams[schema.BlockStorage]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.BlockStorage) error {
 					_, err := api.CreateOrUpdateBlockStorage(ctx, r)
 					return err
@@ -219,12 +222,13 @@ func (configurator *StepsConfigurator) GetImageV1Step(stepName string, api secap
 }
 
 func (configurator *StepsConfigurator) WatchImageUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.StorageV1, tref secapi.TenantReference) {
-	watchTenantResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchTenantResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchTenantResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.TenantReference]{
-				reference: tref,
+				reference:       tref,
+				ledgerReference: imageV1LedgerReference(tref),
 				getErrorFunc: func(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchImageUntilDeleted(configurator.t.Context(), tref, config)
+					return api.WatchImageUntilDeleted(ctx, tref, config)
 				},
 			},
 			stepName:       stepName,
@@ -238,16 +242,16 @@ func (configurator *StepsConfigurator) CreateOrUpdateImageV1Step(stepName string
 	responseExpects ResponseExpects[schema.RegionalResourceMetadata, schema.ImageSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateTenantResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateTenantResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateTenantResourceParams[schema.Image, schema.RegionalResourceMetadata, schema.ImageSpec, schema.ImageStatus]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetStorageV1StepParams,
 			operationName:  constants.CreateOrUpdateImageOperation,
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.Image) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.Image) (
 				wrappers.ResourceWrapper[schema.Image, schema.RegionalResourceMetadata, schema.ImageSpec, schema.ImageStatus], error,
 			) {
-				resp, err := api.CreateOrUpdateImage(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateImage(ctx, resource)
 				return wrappers.NewImageWrapper(resp), err
 			},
 			expectedMetadata:       responseExpects.Metadata,
@@ -255,12 +259,13 @@ func (configurator *StepsConfigurator) CreateOrUpdateImageV1Step(stepName string
 			expectedSpec:           responseExpects.Spec,
 			verifySpecFunc:         configurator.suite.VerifyImageSpecStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            ImageV1LedgerEntry(api, resource),
 		},
 	)
 }
 
 func (configurator *StepsConfigurator) DeleteImageV1Step(stepName string, stepCreator StepCreator, api secapi.StorageV1, resource *schema.Image) {
-	deleteTenantResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteTenantResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteTenantResourceParams[schema.Image]{
 			deleteResourceParams: deleteResourceParams[schema.Image]{
 				resource: resource,
@@ -275,11 +280,12 @@ func (configurator *StepsConfigurator) DeleteImageV1Step(stepName string, stepCr
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateImageExpectViolationV1Step(stepName string, api secapi.StorageV1, resource *schema.Image) {
+func (configurator *StepsConfigurator) CreateOrUpdateImageExpectViolationV1Step(stepName string, api secapi.StorageV1, resource *schema.Image, expected ExpectedError) {
 	violationTenantResourceStep(configurator.t, configurator.suite,
 		actionTenantResourceParams[schema.Image]{
 			actionResourceParams: actionResourceParams[schema.Image]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.Image) error {
 					_, err := api.CreateOrUpdateImage(ctx, r)
 					return err
@@ -0,0 +1,95 @@
+package steps
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+)
+
+const timelineAttachment = "timeline"
+
+// polledResource holds the fields of a polled resource the timeline shows
+type polledResource struct {
+	Metadata struct {
+		ResourceVersion int64 `json:"resourceVersion"`
+	} `json:"metadata"`
+	Status struct {
+		State      schema.ResourceState            `json:"state"`
+		PowerState schema.InstanceStatusPowerState `json:"powerState"`
+		Conditions []schema.StatusCondition        `json:"conditions"`
+	} `json:"status"`
+}
+
+// observedSnapshots reads the resource of each poll from the exchanges of a watch,
+// since the observers of the go-sdk only return the last one
+func observedSnapshots(entries []har.Entry) []suites.Snapshot {
+	var snapshots []suites.Snapshot
+	for _, entry := range entries {
+		if entry.Request.Method != http.MethodGet {
+			continue
+		}
+
+		at, _ := time.Parse(time.RFC3339Nano, entry.StartedDateTime)
+		snapshot := suites.Snapshot{At: at, Status: entry.Response.Status}
+
+		var resource polledResource
+		if entry.Response.Status == http.StatusOK && json.Unmarshal([]byte(entry.Response.Content.Text), &resource) == nil {
+			snapshot.ResourceVersion = resource.Metadata.ResourceVersion
+			snapshot.State = resource.Status.State
+			snapshot.PowerState = resource.Status.PowerState
+			for _, condition := range resource.Status.Conditions {
+				snapshot.Conditions = append(snapshot.Conditions, condition.State)
+			}
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// timelineAttachmentStep attaches the snapshots to the step as a table, one poll per line
+func timelineAttachmentStep(ctx provider.StepCtx, snapshots []suites.Snapshot) {
+	if len(snapshots) == 0 {
+		return
+	}
+
+	var buffer bytes.Buffer
+	writer := tabwriter.NewWriter(&buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "POLL\tAT\tELAPSED\tSTATUS\tVERSION\tSTATE\tPOWER STATE\tCONDITIONS")
+	for i, snapshot := range snapshots {
+		conditions := make([]string, len(snapshot.Conditions))
+		for j, condition := range snapshot.Conditions {
+			conditions[j] = string(condition)
+		}
+		fmt.Fprintf(writer, "%d\t%s\t+%s\t%d\t%d\t%s\t%s\t%s\n",
+			i,
+			snapshot.At.Format(time.RFC3339Nano),
+			snapshot.At.Sub(snapshots[0].At).Round(time.Millisecond),
+			snapshot.Status,
+			snapshot.ResourceVersion,
+			orDash(string(snapshot.State)),
+			orDash(string(snapshot.PowerState)),
+			orDash(strings.Join(conditions, ",")),
+		)
+	}
+	writer.Flush()
+
+	ctx.WithNewAttachment(timelineAttachment, allure.Text, buffer.Bytes())
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
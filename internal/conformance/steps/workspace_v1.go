@@ -16,16 +16,16 @@ func (configurator *StepsConfigurator) CreateOrUpdateWorkspaceV1Step(stepName st
 	responseExpects ResponseExpects[schema.RegionalResourceMetadata, schema.WorkspaceSpec],
 ) {
 	responseExpects.Metadata.Verb = http.MethodPut
-	createOrUpdateTenantResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	createOrUpdateTenantResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		createOrUpdateTenantResourceParams[schema.Workspace, schema.RegionalResourceMetadata, schema.WorkspaceSpec, schema.WorkspaceStatus]{
 			stepName:       stepName,
 			stepParamsFunc: configurator.suite.SetWorkspaceV1StepParams,
 			operationName:  constants.CreateOrUpdateWorkspaceOperation,
 			resource:       resource,
-			createOrUpdateFunc: func(context.Context, *schema.Workspace) (
+			createOrUpdateFunc: func(ctx context.Context, resource *schema.Workspace) (
 				wrappers.ResourceWrapper[schema.Workspace, schema.RegionalResourceMetadata, schema.WorkspaceSpec, schema.WorkspaceStatus], error,
 			) {
-				resp, err := api.CreateOrUpdateWorkspace(configurator.t.Context(), resource)
+				resp, err := api.CreateOrUpdateWorkspace(ctx, resource)
 				return wrappers.NewWorkspaceWrapper(resp), err
 			},
 			expectedLabels:         responseExpects.Labels,
@@ -34,6 +34,7 @@ func (configurator *StepsConfigurator) CreateOrUpdateWorkspaceV1Step(stepName st
 			expectedMetadata:       responseExpects.Metadata,
 			verifyMetadataFunc:     configurator.suite.VerifyRegionalResourceMetadataStep,
 			expectedResourceStates: responseExpects.ResourceStates,
+			ledgerEntry:            WorkspaceV1LedgerEntry(api, resource),
 		},
 	)
 }
@@ -86,12 +87,13 @@ func (configurator *StepsConfigurator) GetWorkspaceV1Step(stepName string, api s
 }
 
 func (configurator *StepsConfigurator) WatchWorkspaceUntilDeletedV1Step(stepName string, stepCreator StepCreator, api secapi.WorkspaceV1, tref secapi.TenantReference) {
-	watchTenantResourceUntilDeletedStep(configurator.t.Context(), configurator.suite, stepCreator,
+	watchTenantResourceUntilDeletedStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		watchTenantResourceUntilDeletedParams{
 			watchResourceUntilDeletedParams: watchResourceUntilDeletedParams[secapi.TenantReference]{
-				reference: tref,
+				reference:       tref,
+				ledgerReference: workspaceV1LedgerReference(tref),
 				getErrorFunc: func(ctx context.Context, tref secapi.TenantReference, config secapi.ResourceObserverConfig) error {
-					return api.WatchWorkspaceUntilDeleted(configurator.t.Context(), tref, config)
+					return api.WatchWorkspaceUntilDeleted(ctx, tref, config)
 				},
 			},
 			stepName:       stepName,
@@ -102,7 +104,7 @@ func (configurator *StepsConfigurator) WatchWorkspaceUntilDeletedV1Step(stepName
 }
 
 func (configurator *StepsConfigurator) DeleteWorkspaceV1Step(stepName string, stepCreator StepCreator, api secapi.WorkspaceV1, resource *schema.Workspace) {
-	deleteTenantResourceStep(configurator.t.Context(), configurator.suite, stepCreator,
+	deleteTenantResourceStep(configurator.suite.Context(configurator.t), configurator.suite, stepCreator,
 		deleteTenantResourceParams[schema.Workspace]{
 			deleteResourceParams: deleteResourceParams[schema.Workspace]{
 				resource: resource,
@@ -117,11 +119,12 @@ func (configurator *StepsConfigurator) DeleteWorkspaceV1Step(stepName string, st
 	)
 }
 
-func (configurator *StepsConfigurator) CreateOrUpdateWorkspaceExpectViolationV1Step(stepName string, api secapi.WorkspaceV1, resource *schema.Workspace) {
+func (configurator *StepsConfigurator) CreateOrUpdateWorkspaceExpectViolationV1Step(stepName string, api secapi.WorkspaceV1, resource *schema.Workspace, expected ExpectedError) {
 	violationTenantResourceStep(configurator.t, configurator.suite,
 		actionTenantResourceParams[schema.Workspace]{
 			actionResourceParams: actionResourceParams[schema.Workspace]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.Workspace) error {
 					_, err := api.CreateOrUpdateWorkspace(ctx, r)
 					return err
@@ -134,11 +137,12 @@ func (configurator *StepsConfigurator) CreateOrUpdateWorkspaceExpectViolationV1S
 	)
 }
 
-func (configurator *StepsConfigurator) DeleteWorkspaceExpectConflictV1Step(stepName string, api secapi.WorkspaceV1, resource *schema.Workspace) {
+func (configurator *StepsConfigurator) DeleteWorkspaceExpectConflictV1Step(stepName string, api secapi.WorkspaceV1, resource *schema.Workspace, expected ExpectedError) {
 	conflictTenantResourceStep(configurator.t, configurator.suite,
 		actionTenantResourceParams[schema.Workspace]{
 			actionResourceParams: actionResourceParams[schema.Workspace]{
 				resource: resource,
+				expected: expected,
 				actionFunc: func(ctx context.Context, r *schema.Workspace) error {
 					return api.DeleteWorkspace(ctx, r)
 				},
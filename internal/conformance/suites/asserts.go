@@ -1,14 +1,14 @@
 package suites
 
 import (
-	"log/slog"
+	"github.com/eu-sovereign-cloud/conformance/internal/logging"
 
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 )
 
 func (suite *TestSuite) verifyAssertState(stepCtx provider.StepCtx) {
 	if stepCtx.CurrentStep().Status != passed {
-		slog.Error("Verification should have no assertion failures")
+		suite.Logger().Error("Verification should have no assertion failures", logging.StepKey, stepCtx.CurrentStep().Name)
 		stepCtx.FailNow()
 	}
 }
@@ -0,0 +1,174 @@
+package suites
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
+	"github.com/eu-sovereign-cloud/conformance/internal/logging"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+)
+
+// verifierPolicy sets how a verifier follows the assertion mode of the run
+type verifierPolicy int
+
+const (
+	// followRunMode stops at the first mismatch, or collects them all with --assertions=soft
+	followRunMode verifierPolicy = iota
+	// alwaysSoft collects the mismatches in any mode, for the verifiers of independent fields such as the metadata
+	alwaysSoft
+)
+
+// mismatch is a field of the verified resource that differs from its expected value
+type mismatch struct {
+	Path     string `json:"path"`
+	Expected any    `json:"expected"`
+	Actual   any    `json:"actual"`
+}
+
+// diff collects the mismatching fields of a verify step by their JSON path, recorded as its diff parameter.
+// In soft mode the step goes on after a mismatch, and Finish stops the scenario at its end
+type diff struct {
+	suite      *TestSuite
+	stepCtx    provider.StepCtx
+	soft       bool
+	mismatches []mismatch
+}
+
+func (suite *TestSuite) newDiff(stepCtx provider.StepCtx, policy verifierPolicy) *diff {
+	return &diff{
+		suite:   suite,
+		stepCtx: stepCtx,
+		soft:    policy == alwaysSoft || suite.params.AssertionMode == config.SoftAssertions,
+	}
+}
+
+func (diff *diff) asserts() provider.Asserts {
+	if diff.soft {
+		return diff.stepCtx.Assert()
+	}
+	return diff.stepCtx.Require()
+}
+
+// add records the mismatch, right away in strict mode since its assertion stops the step
+func (diff *diff) add(path string, expected any, actual any) {
+	diff.mismatches = append(diff.mismatches, mismatch{Path: path, Expected: expected, Actual: actual})
+	if !diff.soft {
+		diff.record()
+	}
+}
+
+func (diff *diff) record() {
+	data, err := json.Marshal(diff.mismatches)
+	if err != nil {
+		diff.suite.Logger().Error("Failed to record the diff", logging.StepKey, diff.stepCtx.CurrentStep().Name, "error", err)
+		return
+	}
+	diff.stepCtx.WithNewParameters(diffStepParameter, string(data))
+}
+
+// Equal verifies the field at the path has the expected value
+func (diff *diff) Equal(path string, expected any, actual any, msgAndArgs ...any) bool {
+	equal := assert.ObjectsAreEqual(expected, actual)
+	if !equal {
+		diff.add(path, expected, actual)
+	}
+	diff.asserts().Equal(expected, actual, msgAndArgs...)
+	return equal
+}
+
+// EqualMap verifies the entries of the map at the path one by one, so each differing key is a mismatch
+func (diff *diff) EqualMap(path string, expected map[string]string, actual map[string]string, msgAndArgs ...any) bool {
+	equal := assert.ObjectsAreEqual(expected, actual)
+	if !equal {
+		keys := map[string]string{}
+		maps.Copy(keys, expected)
+		maps.Copy(keys, actual)
+
+		var mismatches []mismatch
+		for _, key := range slices.Sorted(maps.Keys(keys)) {
+			expectedValue, expectedFound := expected[key]
+			actualValue, actualFound := actual[key]
+			if expectedFound != actualFound || expectedValue != actualValue {
+				mismatches = append(mismatches, mismatch{
+					Path:     path + "/" + pointerToken(key),
+					Expected: valueOrNil(expectedValue, expectedFound),
+					Actual:   valueOrNil(actualValue, actualFound),
+				})
+			}
+		}
+		// The maps differ only by being nil or empty
+		if len(mismatches) == 0 {
+			mismatches = append(mismatches, mismatch{Path: path, Expected: expected, Actual: actual})
+		}
+
+		diff.mismatches = append(diff.mismatches, mismatches...)
+		if !diff.soft {
+			diff.record()
+		}
+	}
+	diff.asserts().Equal(expected, actual, msgAndArgs...)
+	return equal
+}
+
+// Len verifies the list at the path has the expected number of items
+func (diff *diff) Len(path string, expected int, actual int, msgAndArgs ...any) bool {
+	if expected != actual {
+		diff.add(path, fmt.Sprintf("%d items", expected), fmt.Sprintf("%d items", actual))
+	}
+	diff.asserts().Equal(expected, actual, msgAndArgs...)
+	return expected == actual
+}
+
+// NotEmpty verifies the field at the path is set
+func (diff *diff) NotEmpty(path string, actual string, msgAndArgs ...any) bool {
+	if actual == "" {
+		diff.add(path, "not empty", actual)
+	}
+	diff.asserts().NotEmpty(actual, msgAndArgs...)
+	return actual != ""
+}
+
+// MaxLength verifies the field at the path has at most maxLength characters
+func (diff *diff) MaxLength(path string, actual string, maxLength int, msgAndArgs ...any) bool {
+	valid := len(actual) <= maxLength
+	if !valid {
+		diff.add(path, fmt.Sprintf("at most %d characters", maxLength), actual)
+	}
+	diff.asserts().LessOrEqual(len(actual), maxLength, msgAndArgs...)
+	return valid
+}
+
+// True verifies a condition on the field at the path, with the expected value describing it
+func (diff *diff) True(path string, valid bool, expected any, actual any, msgAndArgs ...any) bool {
+	if !valid {
+		diff.add(path, expected, actual)
+	}
+	diff.asserts().True(valid, msgAndArgs...)
+	return valid
+}
+
+// Finish records the mismatches collected in soft mode and stops the scenario when there is any
+func (diff *diff) Finish() {
+	if diff.soft && len(diff.mismatches) > 0 {
+		diff.record()
+	}
+	diff.suite.verifyAssertState(diff.stepCtx)
+}
+
+// pointerToken escapes a key as a JSON pointer token
+func pointerToken(key string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
+
+func valueOrNil(value string, found bool) any {
+	if !found {
+		return nil
+	}
+	return value
+}
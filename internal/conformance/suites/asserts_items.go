@@ -9,14 +9,16 @@ import (
 
 func (suite *TestSuite) VerifyRegionItemsStep(ctx provider.StepCtx, items []*schema.Region) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotEmpty(item, "Region items should not be empty")
 			stepCtx.Require().NotNil(item.Metadata, "Region item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsGlobalResourceMetadataFilled(stepCtx, item.Metadata, "Region", i)
+			suite.verifyItemsGlobalResourceMetadataFilled(diff, item.Metadata, "Region", i)
 
 		}
+		diff.Finish()
 	})
 }
 
@@ -37,14 +39,16 @@ func (suite *TestSuite) VerifyInstanceSkuItemsStep(ctx provider.StepCtx, items [
 
 func (suite *TestSuite) VerifyInstanceItemsStep(ctx provider.StepCtx, items []*schema.Instance) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotEmpty(item, "Instance items should not be empty")
 			stepCtx.Require().NotNil(item.Metadata, "Instance item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsRegionalWorkspaceResourceMetadataFilled(stepCtx, item.Metadata, "Instance", i)
+			suite.verifyItemsRegionalWorkspaceResourceMetadataFilled(diff, item.Metadata, "Instance", i)
 
 		}
+		diff.Finish()
 	})
 }
 
@@ -64,105 +68,121 @@ func (suite *TestSuite) VerifyNetworkSkuItemsStep(ctx provider.StepCtx, items []
 
 func (suite *TestSuite) VerifyNetworkItemsStep(ctx provider.StepCtx, items []*schema.Network) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotEmpty(item, "Network items should not be empty")
 			stepCtx.Require().NotNil(item.Metadata, "Network item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsRegionalWorkspaceResourceMetadataFilled(stepCtx, item.Metadata, "Network", i)
+			suite.verifyItemsRegionalWorkspaceResourceMetadataFilled(diff, item.Metadata, "Network", i)
 
 		}
+		diff.Finish()
 	})
 }
 
 func (suite *TestSuite) VerifyInternetGatewayItemsStep(ctx provider.StepCtx, items []*schema.InternetGateway) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotEmpty(item, "InternetGateway items should not be empty")
 			stepCtx.Require().NotNil(item.Metadata, "InternetGateway item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsRegionalWorkspaceResourceMetadataFilled(stepCtx, item.Metadata, "InternetGateway", i)
+			suite.verifyItemsRegionalWorkspaceResourceMetadataFilled(diff, item.Metadata, "InternetGateway", i)
 
 		}
+		diff.Finish()
 	})
 }
 
 func (suite *TestSuite) VerifyRouteTableItemsStep(ctx provider.StepCtx, items []*schema.RouteTable) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotEmpty(item, "RouteTable items should not be empty")
 			stepCtx.Require().NotNil(item.Metadata, "RouteTable item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsRegionalNetworkResourceMetadataFilled(stepCtx, item.Metadata, "RouteTable", i)
+			suite.verifyItemsRegionalNetworkResourceMetadataFilled(diff, item.Metadata, "RouteTable", i)
 
 		}
+		diff.Finish()
 	})
 }
 
 func (suite *TestSuite) VerifySubnetItemsStep(ctx provider.StepCtx, items []*schema.Subnet) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotEmpty(item, "Subnet items should not be empty")
 			stepCtx.Require().NotNil(item.Metadata, "Subnet item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsRegionalNetworkResourceMetadataFilled(stepCtx, item.Metadata, "Subnet", i)
+			suite.verifyItemsRegionalNetworkResourceMetadataFilled(diff, item.Metadata, "Subnet", i)
 
 		}
+		diff.Finish()
 	})
 }
 
 func (suite *TestSuite) VerifyPublicIpItemsStep(ctx provider.StepCtx, items []*schema.PublicIp) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotEmpty(item, "PublicIp items should not be empty")
 			stepCtx.Require().NotNil(item.Metadata, "PublicIp item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsRegionalWorkspaceResourceMetadataFilled(stepCtx, item.Metadata, "PublicIp", i)
+			suite.verifyItemsRegionalWorkspaceResourceMetadataFilled(diff, item.Metadata, "PublicIp", i)
 
 		}
+		diff.Finish()
 	})
 }
 
 func (suite *TestSuite) VerifyNicItemsStep(ctx provider.StepCtx, items []*schema.Nic) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotEmpty(item, "NIC items should not be empty")
 			stepCtx.Require().NotNil(item.Metadata, "NIC item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsRegionalWorkspaceResourceMetadataFilled(stepCtx, item.Metadata, "NIC", i)
+			suite.verifyItemsRegionalWorkspaceResourceMetadataFilled(diff, item.Metadata, "NIC", i)
 
 		}
+		diff.Finish()
 	})
 }
 
 func (suite *TestSuite) VerifySecurityGroupRuleItemsStep(ctx provider.StepCtx, items []*schema.SecurityGroupRule) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotEmpty(item, "SecurityGroupRule items should not be empty")
 			stepCtx.Require().NotNil(item.Metadata, "SecurityGroupRule item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsRegionalWorkspaceResourceMetadataFilled(stepCtx, item.Metadata, "SecurityGroupRule", i)
+			suite.verifyItemsRegionalWorkspaceResourceMetadataFilled(diff, item.Metadata, "SecurityGroupRule", i)
 
 		}
+		diff.Finish()
 	})
 }
 
 func (suite *TestSuite) VerifySecurityGroupItemsStep(ctx provider.StepCtx, items []*schema.SecurityGroup) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotEmpty(item, "SecurityGroup items should not be empty")
 			stepCtx.Require().NotNil(item.Metadata, "SecurityGroup item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsRegionalWorkspaceResourceMetadataFilled(stepCtx, item.Metadata, "SecurityGroup", i)
+			suite.verifyItemsRegionalWorkspaceResourceMetadataFilled(diff, item.Metadata, "SecurityGroup", i)
 
 		}
+		diff.Finish()
 	})
 }
 
@@ -182,26 +202,30 @@ func (suite *TestSuite) VerifyStorageSkuItemsStep(ctx provider.StepCtx, items []
 
 func (suite *TestSuite) VerifyBlockStorageItemsStep(ctx provider.StepCtx, items []*schema.BlockStorage) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotEmpty(item, "BlockStorage items should not be empty")
 			stepCtx.Require().NotNil(item.Metadata, "BlockStorage item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsRegionalWorkspaceResourceMetadataFilled(stepCtx, item.Metadata, "BlockStorage", i)
+			suite.verifyItemsRegionalWorkspaceResourceMetadataFilled(diff, item.Metadata, "BlockStorage", i)
 
 		}
+		diff.Finish()
 	})
 }
 
 func (suite *TestSuite) VerifyImageItemsStep(ctx provider.StepCtx, items []*schema.Image) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotEmpty(item, "Image items should not be empty")
 			stepCtx.Require().NotNil(item.Metadata, "Image item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsRegionalResourceMetadataFilled(stepCtx, item.Metadata, "Image", i)
+			suite.verifyItemsRegionalResourceMetadataFilled(diff, item.Metadata, "Image", i)
 		}
+		diff.Finish()
 	})
 }
 
@@ -209,13 +233,15 @@ func (suite *TestSuite) VerifyImageItemsStep(ctx provider.StepCtx, items []*sche
 
 func (suite *TestSuite) VerifyWorkspaceItemsStep(ctx provider.StepCtx, items []*schema.Workspace) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotNil(item, "Workspace item[%d] should not be nil", i)
 			stepCtx.Require().NotNil(item.Metadata, "Workspace item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsRegionalResourceMetadataFilled(stepCtx, item.Metadata, "Workspace", i)
+			suite.verifyItemsRegionalResourceMetadataFilled(diff, item.Metadata, "Workspace", i)
 		}
+		diff.Finish()
 	})
 }
 
@@ -223,24 +249,28 @@ func (suite *TestSuite) VerifyWorkspaceItemsStep(ctx provider.StepCtx, items []*
 
 func (suite *TestSuite) VerifyRoleItemsStep(ctx provider.StepCtx, items []*schema.Role) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotNil(item, "Role item[%d] should not be nil", i)
 			stepCtx.Require().NotNil(item.Metadata, "Role item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsGlobalTenantResourceMetadataFilled(stepCtx, item.Metadata, "Role", i)
+			suite.verifyItemsGlobalTenantResourceMetadataFilled(diff, item.Metadata, "Role", i)
 		}
+		diff.Finish()
 	})
 }
 
 func (suite *TestSuite) VerifyRoleAssignmentItemsStep(ctx provider.StepCtx, items []*schema.RoleAssignment) {
 	ctx.WithNewStep("Verify items", func(stepCtx provider.StepCtx) {
+		diff := suite.newDiff(stepCtx, followRunMode)
 		for i, item := range items {
 			stepCtx.Require().NotNil(item, "RoleAssignment item[%d] should not be nil", i)
 			stepCtx.Require().NotNil(item.Metadata, "RoleAssignment item[%d] metadata should not be nil", i)
 
 			// Metadata
-			suite.VerifyItemsGlobalTenantResourceMetadataFilled(stepCtx, item.Metadata, "RoleAssignment", i)
+			suite.verifyItemsGlobalTenantResourceMetadataFilled(diff, item.Metadata, "RoleAssignment", i)
 		}
+		diff.Finish()
 	})
 }
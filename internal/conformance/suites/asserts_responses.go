@@ -0,0 +1,41 @@
+package suites
+
+import (
+	"context"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+)
+
+// VerifyResponsesStep validates the responses received since the previous verification against the OpenAPI documents,
+// each violation being a soft assertion so the step goes on with the remaining ones
+func (suite *TestSuite) VerifyResponsesStep(ctx context.Context, sCtx provider.StepCtx) {
+	if suite.clients.Responses == nil || suite.exchanges == nil {
+		return
+	}
+	entries := suite.exchanges.Since(suite.verifiedExchanges)
+	suite.verifiedExchanges += len(entries)
+	if len(entries) == 0 {
+		return
+	}
+
+	sCtx.WithNewStep("Verify responses against the OpenAPI documents", func(stepCtx provider.StepCtx) {
+		stepCtx.WithNewParameters("responses", len(entries))
+		for _, entry := range entries {
+			for _, violation := range suite.clients.Responses.Validate(ctx, entry) {
+				// The violations of the response itself, e.g. an undocumented status, have no pointer
+				stepName := "Verify response"
+				if violation.Pointer != "" {
+					stepName += " at " + violation.Pointer
+				}
+				stepCtx.WithNewStep(stepName, func(violationCtx provider.StepCtx) {
+					violationCtx.WithNewParameters(
+						"request", entry.Request.Method+" "+entry.Request.URL,
+						"status", entry.Response.Status,
+						"pointer", violation.Pointer,
+					)
+					violationCtx.Assert().Empty(violation.Reason, "Response should conform to the OpenAPI document")
+				})
+			}
+		}
+	})
+}
@@ -2,6 +2,8 @@ package suites
 
 import (
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
@@ -9,38 +11,50 @@ import (
 
 func (suite *TestSuite) VerifyStatusStateStep(ctx provider.StepCtx, expected schema.ResourceState, actual schema.ResourceState) {
 	ctx.WithNewStep("Verify status state", func(stepCtx provider.StepCtx) {
-		stepCtx.Require().Equal(expected, actual, "Status state should match expected")
+		diff := suite.newDiff(stepCtx, followRunMode)
+		diff.Equal("/status/state", expected, actual, "Status state should match expected")
+		diff.Finish()
 	})
 }
 
 func (suite *TestSuite) VerifyStatusStatesStep(ctx provider.StepCtx, expected []schema.ResourceState, actual schema.ResourceState) {
 	ctx.WithNewStep("Verify status state", func(stepCtx provider.StepCtx) {
-		stepCtx.Require().Contains(expected, actual, "Status state should match expected")
+		diff := suite.newDiff(stepCtx, followRunMode)
+		diff.True("/status/state", slices.Contains(expected, actual), expected, actual, "Status state should match expected")
+		diff.Finish()
 	})
 }
 
 func (suite *TestSuite) VerifyStatusConditionsStep(ctx provider.StepCtx, expected []schema.StatusCondition, actual []schema.StatusCondition) {
 	ctx.WithNewStep("Verify status conditions", func(stepCtx provider.StepCtx) {
-		stepCtx.Require().Equal(len(expected), len(actual), "Status conditions length should match expected")
-		for i := range expected {
-			stepCtx.Require().Equal(expected[i].State, actual[i].State,
-				fmt.Sprintf("Condition [%d] state should match expected", i))
+		diff := suite.newDiff(stepCtx, followRunMode)
+		// The conditions are compared by index, so there is nothing more to compare when their number differs
+		if diff.Len("/status/conditions", len(expected), len(actual), "Status conditions length should match expected") {
+			for i := range expected {
+				path := fmt.Sprintf("/status/conditions/%d", i)
+				diff.Equal(path+"/state", expected[i].State, actual[i].State,
+					fmt.Sprintf("Condition [%d] state should match expected", i))
 
-			stepCtx.Require().NotEmpty(actual[i].LastTransitionAt)
-			stepCtx.Require().False(actual[i].LastTransitionAt.IsZero(),
-				fmt.Sprintf("Condition [%d] lastTransitionAt should not be zero", i))
+				lastTransitionAt := actual[i].LastTransitionAt
+				diff.True(path+"/lastTransitionAt", !lastTransitionAt.IsZero(), "set", lastTransitionAt,
+					fmt.Sprintf("Condition [%d] lastTransitionAt should not be zero", i))
 
-			if i != 0 {
-				stepCtx.Require().False(actual[i-1].LastTransitionAt.Before(actual[i].LastTransitionAt),
-					fmt.Sprintf("Condition [%d] lastTransitionAt should be after to previous condition's lastTransitionAt", i))
+				if i != 0 {
+					previous := actual[i-1].LastTransitionAt
+					diff.True(path+"/lastTransitionAt", !previous.Before(lastTransitionAt), "not after "+previous.Format(time.RFC3339Nano), lastTransitionAt,
+						fmt.Sprintf("Condition [%d] lastTransitionAt should be after to previous condition's lastTransitionAt", i))
+				}
 			}
 		}
+		diff.Finish()
 	})
 }
 
 func (suite *TestSuite) VerifyStatusPowerStateStep(ctx provider.StepCtx, expected schema.InstanceStatusPowerState, actual schema.InstanceStatusPowerState) {
 	ctx.WithNewStep("Verify status power state", func(stepCtx provider.StepCtx) {
-		stepCtx.Require().Equal(expected, actual, "Status power state should match expected")
+		diff := suite.newDiff(stepCtx, followRunMode)
+		diff.Equal("/status/powerState", expected, actual, "Status power state should match expected")
+		diff.Finish()
 	})
 }
 
@@ -51,7 +65,9 @@ func (suite *TestSuite) VerifyLabelsStep(ctx provider.StepCtx, expected schema.L
 			"actual_labels", actual,
 		)
 
-		stepCtx.Require().Equal(expected, actual, "Labels should match expected")
+		diff := suite.newDiff(stepCtx, followRunMode)
+		diff.EqualMap("/labels", expected, actual, "Labels should match expected")
+		diff.Finish()
 	})
 	ctx.WithNewStep("Verify label constraints", func(stepCtx provider.StepCtx) {
 		stepCtx.WithNewParameters(
@@ -70,7 +86,9 @@ func (suite *TestSuite) VerifyAnnotationsStep(ctx provider.StepCtx, expected sch
 			"actual_annotations", actual,
 		)
 
-		stepCtx.Require().Equal(expected, actual, "Annotations should match expected")
+		diff := suite.newDiff(stepCtx, followRunMode)
+		diff.EqualMap("/annotations", expected, actual, "Annotations should match expected")
+		diff.Finish()
 	})
 
 	ctx.WithNewStep("Verify annotation constraints", func(stepCtx provider.StepCtx) {
@@ -90,6 +108,8 @@ func (suite *TestSuite) VerifyExtensionsStep(ctx provider.StepCtx, expected sche
 			"actual_extensions", actual,
 		)
 
-		stepCtx.Require().Equal(expected, actual, "Extensions should match expected")
+		diff := suite.newDiff(stepCtx, followRunMode)
+		diff.EqualMap("/extensions", expected, actual, "Extensions should match expected")
+		diff.Finish()
 	})
 }
@@ -0,0 +1,133 @@
+package suites
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+	"github.com/eu-sovereign-cloud/conformance/internal/logging"
+	"github.com/eu-sovereign-cloud/conformance/internal/slo"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+)
+
+// mutation is a call changing a resource, from which the time to reach its target state is measured
+type mutation struct {
+	operation constants.OperationName
+	at        time.Time
+}
+
+// VerifyTimingsStep measures the calls of the step: the latency of the slowest one and, once the resource reached its
+// target state, the time since the call that last changed it. Each measure is checked against the threshold of its operation
+func (suite *TestSuite) VerifyTimingsStep(ctx provider.StepCtx, entries []har.Entry, converged bool) {
+	if len(entries) == 0 {
+		return
+	}
+	operation := stepOperation(ctx)
+
+	var latency time.Duration
+	for _, entry := range entries {
+		latency = max(latency, milliseconds(entry.Time))
+	}
+	ctx.WithNewParameters(latencyStepParameter, latency.Milliseconds())
+	if threshold, found := slo.Find(suite.params.Thresholds, operation, slo.LatencyMetric); found {
+		ctx.WithNewStep("Verify latency", func(stepCtx provider.StepCtx) {
+			suite.verifyThreshold(stepCtx, threshold, latency)
+		})
+	}
+
+	if converged {
+		last := entries[len(entries)-1]
+		if changed, found := suite.takeMutation(entryPath(last)); found {
+			convergence := entryStarted(last).Add(milliseconds(last.Time)).Sub(changed.at)
+			ctx.WithNewStep("Verify convergence of "+string(changed.operation), func(stepCtx provider.StepCtx) {
+				stepCtx.WithNewParameters(operationStepParameter, changed.operation, convergenceStepParameter, convergence.Milliseconds())
+				if threshold, found := slo.Find(suite.params.Thresholds, changed.operation, slo.ConvergenceMetric); found {
+					suite.verifyThreshold(stepCtx, threshold, convergence)
+				}
+			})
+		}
+	}
+
+	for _, entry := range entries {
+		if entry.Request.Method != http.MethodGet && entry.Response.Status >= 200 && entry.Response.Status < 300 {
+			suite.putMutation(entryPath(entry), mutation{operation: operation, at: entryStarted(entry)})
+		}
+	}
+}
+
+// verifyThreshold fails the step exceeding the threshold when they are blocking, and only warns otherwise,
+// the summary reporting the exceeded thresholds in both cases
+func (suite *TestSuite) verifyThreshold(stepCtx provider.StepCtx, threshold slo.Threshold, measure time.Duration) {
+	stepCtx.WithNewParameters(thresholdStepParameter, threshold.String())
+	if measure <= threshold.Limit {
+		return
+	}
+
+	if suite.params.SloBlocking {
+		stepCtx.Assert().LessOrEqual(measure, threshold.Limit,
+			fmt.Sprintf("%s %s should be within %s", threshold.Operation, threshold.Metric, threshold.Limit))
+		return
+	}
+	suite.Logger().Warn("Threshold exceeded", logging.OperationKey, threshold.Operation, "threshold", threshold.String(), "measure", measure)
+}
+
+func (suite *TestSuite) putMutation(path string, changed mutation) {
+	suite.mutationsLock.Lock()
+	defer suite.mutationsLock.Unlock()
+
+	if suite.mutations == nil {
+		suite.mutations = map[string]mutation{}
+	}
+	suite.mutations[path] = changed
+}
+
+// takeMutation returns the latest change of the resource at the path, or of its actions, and forgets them
+func (suite *TestSuite) takeMutation(path string) (mutation, bool) {
+	suite.mutationsLock.Lock()
+	defer suite.mutationsLock.Unlock()
+
+	var latest mutation
+	var found bool
+	for changedPath, changed := range suite.mutations {
+		if changedPath != path && !strings.HasPrefix(changedPath, path+"/") {
+			continue
+		}
+		if !found || changed.at.After(latest.at) {
+			latest, found = changed, true
+		}
+		delete(suite.mutations, changedPath)
+	}
+	return latest, found
+}
+
+// stepOperation returns the operation set as a parameter of the step
+func stepOperation(ctx provider.StepCtx) constants.OperationName {
+	for _, parameter := range ctx.CurrentStep().Parameters {
+		if parameter.Name == operationStepParameter {
+			return constants.OperationName(fmt.Sprint(parameter.Value))
+		}
+	}
+	return ""
+}
+
+func entryPath(entry har.Entry) string {
+	parsed, err := url.Parse(entry.Request.URL)
+	if err != nil {
+		return entry.Request.URL
+	}
+	return parsed.Path
+}
+
+func entryStarted(entry har.Entry) time.Time {
+	started, _ := time.Parse(time.RFC3339Nano, entry.StartedDateTime)
+	return started
+}
+
+func milliseconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Millisecond))
+}
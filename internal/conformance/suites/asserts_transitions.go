@@ -0,0 +1,58 @@
+package suites
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+)
+
+// Snapshot is the resource observed by a poll of a watch step, without a state when the poll returned no resource
+type Snapshot struct {
+	At              time.Time                       `json:"at"`
+	Status          int                             `json:"status"`
+	ResourceVersion int64                           `json:"resourceVersion,omitempty"`
+	State           schema.ResourceState            `json:"state,omitempty"`
+	PowerState      schema.InstanceStatusPowerState `json:"powerState,omitempty"`
+	Conditions      []schema.ResourceState          `json:"conditions,omitempty"`
+}
+
+// VerifyStateTransitionsStep verifies the lifecycle observed by the polls of a watch step: each state change is an allowed transition,
+// the resource version never decreases and no condition is dropped
+func (suite *TestSuite) VerifyStateTransitionsStep(ctx provider.StepCtx, snapshots []Snapshot) {
+	ctx.WithNewStep("Verify state transitions", func(stepCtx provider.StepCtx) {
+		stepCtx.WithNewParameters(pollsStepParameter, len(snapshots))
+
+		diff := suite.newDiff(stepCtx, followRunMode)
+		var previous *Snapshot
+		for i := range snapshots {
+			current := &snapshots[i]
+			if current.State == "" {
+				continue
+			}
+
+			if previous != nil {
+				path := fmt.Sprintf("/polls/%d", i)
+				if current.State != previous.State {
+					allowed := constants.ResourceStateTransitions[previous.State]
+					diff.True(path+"/status/state", slices.Contains(allowed, current.State), allowed, current.State,
+						fmt.Sprintf("Poll [%d] state should be an allowed transition from %s", i, previous.State))
+				}
+
+				diff.True(path+"/metadata/resourceVersion", current.ResourceVersion >= previous.ResourceVersion,
+					fmt.Sprintf("at least %d", previous.ResourceVersion), current.ResourceVersion,
+					fmt.Sprintf("Poll [%d] resourceVersion should not decrease", i))
+
+				diff.True(path+"/status/conditions", len(current.Conditions) >= len(previous.Conditions),
+					fmt.Sprintf("at least %d items", len(previous.Conditions)), fmt.Sprintf("%d items", len(current.Conditions)),
+					fmt.Sprintf("Poll [%d] conditions should not be dropped", i))
+			}
+			previous = current
+		}
+		diff.Finish()
+	})
+}
@@ -1,7 +1,6 @@
 package authorization
 
 import (
-	"log/slog"
 	"math/rand"
 	"net/http"
 
@@ -33,6 +32,18 @@ func CreateProviderLifeCycleV1TestSuite(globalTestSuite suites.GlobalTestSuite,
 		Users:           users,
 	}
 	suite.ScenarioName = constants.AuthorizationProviderLifeCycleV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Users:     1,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.LifeCycleKind,
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Resources: []string{
+			string(schema.GlobalTenantResourceMetadataKindResourceKindRole),
+			string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment),
+		},
+	}
 	return suite
 }
 
@@ -130,17 +141,13 @@ func (suite *ProviderLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 	suite.params = params
 	err = suites.SetupMockIfEnabled(suite.TestSuite, mockauthorization.ConfigureProviderLifecycleScenarioV1, *params)
 	if err != nil {
-		slog.Error("Failed to setup mock", "error", err)
+		suite.Logger().Error("Failed to setup mock", "error", err)
 		t.FailNow()
 	}
 }
 
 func (suite *ProviderLifeCycleV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.AuthorizationProviderV1Name)
-	suite.ConfigureResources(t,
-		string(schema.GlobalTenantResourceMetadataKindResourceKindRole),
-		string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -289,5 +296,6 @@ func (suite *ProviderLifeCycleV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *ProviderLifeCycleV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
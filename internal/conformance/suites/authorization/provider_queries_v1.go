@@ -14,7 +14,6 @@ import (
 	sdkconsts "github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
 	"github.com/eu-sovereign-cloud/go-sdk/secapi"
-	labelBuilder "github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
 
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 )
@@ -33,6 +32,18 @@ func CreateProviderQueriesV1TestSuite(globalTestSuite suites.GlobalTestSuite, us
 		Users:           users,
 	}
 	suite.ScenarioName = constants.AuthorizationProviderQueriesV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Users:     1,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.QueriesKind,
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Resources: []string{
+			string(schema.GlobalTenantResourceMetadataKindResourceKindRole),
+			string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment),
+		},
+	}
 	return suite
 }
 
@@ -185,11 +196,7 @@ func (suite *ProviderQueriesV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.AuthorizationProviderV1Name)
-	suite.ConfigureResources(t,
-		string(schema.GlobalTenantResourceMetadataKindResourceKindRole),
-		string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -212,17 +219,17 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List Roles with limit
 	stepsBuilder.ListRoleV1Step("List roles with limit", suite.Client.AuthorizationV1, tpath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		roleExpects)
 
 	// List Roles with Label
 	stepsBuilder.ListRoleV1Step("List roles with label", suite.Client.AuthorizationV1, tpath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()),
 		roleExpects)
 
 	// List Roles with Limit and label
 	stepsBuilder.ListRoleV1Step("List roles with limit and label", suite.Client.AuthorizationV1, tpath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		roleExpects)
 
 	// Role assignment
@@ -249,12 +256,12 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List RoleAssignments with Label
 	stepsBuilder.ListRoleAssignmentsV1("List role assignments", suite.Client.AuthorizationV1, tpath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()),
 		roleAssignmentExpects)
 
 	// List RoleAssignments with Limit and label
 	stepsBuilder.ListRoleAssignmentsV1("List role assignments with limit and label", suite.Client.AuthorizationV1, tpath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		roleAssignmentExpects)
 
 	// Delete all role assignments
@@ -267,5 +274,6 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *ProviderQueriesV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
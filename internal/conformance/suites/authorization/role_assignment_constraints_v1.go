@@ -37,6 +37,15 @@ func CreateRoleAssignmentConstraintsValidationV1TestSuite(globalTestSuite suites
 		Users:           users,
 	}
 	suite.ScenarioName = constants.RoleAssignmentConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Users:     1,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Resources: []string{string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment)},
+	}
 	return suite
 }
 
@@ -312,8 +321,7 @@ func (suite *RoleAssignmentConstraintsValidationV1TestSuite) BeforeAll(t provide
 }
 
 func (suite *RoleAssignmentConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.AuthorizationProviderV1Name)
-	suite.ConfigureResources(t, string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -321,120 +329,144 @@ func (suite *RoleAssignmentConstraintsValidationV1TestSuite) TestScenario(t prov
 		"Create a role assignment with name exceeding maxLength:128 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthNameRoleAssignment,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.InvalidPatternNameRoleAssignment,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthLabelValueRoleAssignment,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthAnnotationRoleAssignment,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with sub exceeding maxLength:128 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthSubRoleAssignment,
+		steps.ValidationError().At("/spec/subs/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with role name exceeding maxLength:64 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthRoleNameRoleAssignment,
+		steps.ValidationError().At("/spec/roles/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with scope tenant exceeding maxLength:64 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthScopeTenantRoleAssignment,
+		steps.ValidationError().At("/spec/scopes/0/tenants/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with scope region exceeding maxLength:64 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthScopeRegionRoleAssignment,
+		steps.ValidationError().At("/spec/scopes/0/regions/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with scope workspace exceeding maxLength:64 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthScopeWorkspaceRoleAssignment,
+		steps.ValidationError().At("/spec/scopes/0/workspaces/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with roles empty (minItems:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyRolesRoleAssignment,
+		steps.ValidationError().At("/spec/roles"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with roles exceeding maxItems:32 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverMaxItemsRolesRoleAssignment,
+		steps.ValidationError().At("/spec/roles"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with empty role value (minLength:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyRoleValueRoleAssignment,
+		steps.ValidationError().At("/spec/roles/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with subs empty (minItems:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptySubsRoleAssignment,
+		steps.ValidationError().At("/spec/subs"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with subs exceeding maxItems:256 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverMaxItemsSubsRoleAssignment,
+		steps.ValidationError().At("/spec/subs"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with empty sub value (minLength:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptySubValueRoleAssignment,
+		steps.ValidationError().At("/spec/subs/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with scopes empty (minItems:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyScopesRoleAssignment,
+		steps.ValidationError().At("/spec/scopes"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with scopes exceeding maxItems:256 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverMaxItemsScopesRoleAssignment,
+		steps.ValidationError().At("/spec/scopes"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with empty scope tenant value (minLength:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyScopeTenantValueRoleAssignment,
+		steps.ValidationError().At("/spec/scopes/0/tenants/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with scope tenants exceeding maxItems:64 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverMaxItemsScopeTenantsRoleAssignment,
+		steps.ValidationError().At("/spec/scopes/0/tenants"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with empty scope region value (minLength:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyScopeRegionValueRoleAssignment,
+		steps.ValidationError().At("/spec/scopes/0/regions/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with scope regions exceeding maxItems:64 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverMaxItemsScopeRegionsRoleAssignment,
+		steps.ValidationError().At("/spec/scopes/0/regions"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with empty scope workspace value (minLength:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyScopeWorkspaceValueRoleAssignment,
+		steps.ValidationError().At("/spec/scopes/0/workspaces/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with scope workspaces exceeding maxItems:256 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverMaxItemsScopeWorkspacesRoleAssignment,
+		steps.ValidationError().At("/spec/scopes/0/workspaces"),
 	)
 	suite.FinishScenario()
 }
 
 func (suite *RoleAssignmentConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
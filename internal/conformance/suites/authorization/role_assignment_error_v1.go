@@ -37,6 +37,16 @@ func CreateRoleAssignmentErrorV1TestSuite(globalTestSuite suites.GlobalTestSuite
 		Users:           users,
 	}
 	suite.ScenarioName = constants.RoleAssignmentErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Users:     1,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Resources: []string{string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment)},
+		Depends:   []string{string(schema.GlobalTenantResourceMetadataKindResourceKindRole)},
+	}
 	return suite
 }
 
@@ -158,9 +168,7 @@ func (suite *RoleAssignmentErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *RoleAssignmentErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.AuthorizationProviderV1Name)
-	suite.ConfigureResources(t, string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment))
-	suite.ConfigureDepends(t, string(schema.GlobalTenantResourceMetadataKindResourceKindRole))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -169,35 +177,41 @@ func (suite *RoleAssignmentErrorV1TestSuite) TestScenario(t provider.T) {
 		"Create a role assignment with non-existent role ref — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.NonExistentRoleRefRoleAssignment,
+		steps.ValidationError().At("/spec/roles/0"),
 	)
 
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with non-existent tenant in scope — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.NonExistentScopeTenantRoleAssignment,
+		steps.ValidationError().At("/spec/scopes/0/tenants/0"),
 	)
 
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with non-existent region in scope — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.NonExistentScopeRegionRoleAssignment,
+		steps.ValidationError().At("/metadata/region"),
 	)
 
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with non-existent workspace in scope — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.NonExistentScopeWorkspaceRoleAssignment,
+		steps.ValidationError().At("/spec/scopes/0/workspaces/0"),
 	)
 
 	stepsBuilder.CreateOrUpdateRoleAssignmentExpectViolationV1Step(
 		"Create a role assignment with non-existent sub — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.NonExistentSubRoleAssignment,
+		steps.ValidationError().At("/spec/subs/0"),
 	)
 
 	suite.FinishScenario()
 }
 
 func (suite *RoleAssignmentErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
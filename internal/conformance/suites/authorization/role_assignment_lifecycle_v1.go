@@ -1,7 +1,6 @@
 package authorization
 
 import (
-	"log/slog"
 	"math/rand"
 
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/params"
@@ -32,6 +31,15 @@ func CreateRoleAssignmentLifeCycleV1TestSuite(globalTestSuite suites.GlobalTestS
 		Users:           users,
 	}
 	suite.ScenarioName = constants.RoleAssignmentLifeCycleV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Users:     1,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.LifeCycleKind,
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Resources: []string{string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment)},
+	}
 	return suite
 }
 
@@ -96,14 +104,13 @@ func (suite *RoleAssignmentLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 	suite.params = params
 	err = suites.SetupMockIfEnabled(suite.TestSuite, mockauthorization.ConfigureRoleAssignmentLifecycleScenarioV1, *params)
 	if err != nil {
-		slog.Error("Failed to setup mock", "error", err)
+		suite.Logger().Error("Failed to setup mock", "error", err)
 		t.FailNow()
 	}
 }
 
 func (suite *RoleAssignmentLifeCycleV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.AuthorizationProviderV1Name)
-	suite.ConfigureResources(t, string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -180,5 +187,6 @@ func (suite *RoleAssignmentLifeCycleV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *RoleAssignmentLifeCycleV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -36,6 +36,14 @@ func CreateRoleConstraintsValidationV1TestSuite(globalTestSuite suites.GlobalTes
 		GlobalTestSuite: globalTestSuite,
 	}
 	suite.ScenarioName = constants.RoleConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Resources: []string{string(schema.GlobalTenantResourceMetadataKindResourceKindRole)},
+	}
 	return suite
 }
 
@@ -199,8 +207,7 @@ func (suite *RoleConstraintsValidationV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *RoleConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.AuthorizationProviderV1Name)
-	suite.ConfigureResources(t, string(schema.GlobalTenantResourceMetadataKindResourceKindRole))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -208,86 +215,103 @@ func (suite *RoleConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
 		"Create a role with name exceeding maxLength:128 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthNameRole,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.InvalidPatternNameRole,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthLabelValueRole,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthAnnotationRole,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with permission provider exceeding maxLength:64 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthPermissionProviderRole,
+		steps.ValidationError().At("/spec/permissions/0/provider"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with permission resource exceeding maxLength:256 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthPermissionResourceRole,
+		steps.ValidationError().At("/spec/permissions/0/resources/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with permission verb exceeding maxLength:7 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverLengthPermissionVerbRole,
+		steps.ValidationError().At("/spec/permissions/0/verb/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with permissions empty (minItems:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyPermissionsRole,
+		steps.ValidationError().At("/spec/permissions"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with permissions exceeding maxItems:256 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverMaxItemsPermissionsRole,
+		steps.ValidationError().At("/spec/permissions"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with empty permission provider (minLength:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyPermissionProviderRole,
+		steps.ValidationError().At("/spec/permissions/0/provider"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with permission resources empty (minItems:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyPermissionResourcesRole,
+		steps.ValidationError().At("/spec/permissions/0/resources"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with permission resources exceeding maxItems:256 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverMaxItemsPermissionResourcesRole,
+		steps.ValidationError().At("/spec/permissions/0/resources"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with empty permission resource value (minLength:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyPermissionResourceValueRole,
+		steps.ValidationError().At("/spec/permissions/0/resources/0"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with permission verbs empty (minItems:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyPermissionVerbsRole,
+		steps.ValidationError().At("/spec/permissions/0/verb"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with permission verbs exceeding maxItems:16 — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.OverMaxItemsPermissionVerbsRole,
+		steps.ValidationError().At("/spec/permissions/0/verb"),
 	)
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with empty permission verb value (minLength:1) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyPermissionVerbValueRole,
+		steps.ValidationError().At("/spec/permissions/0/verb/0"),
 	)
 
 	suite.FinishScenario()
 }
 
 func (suite *RoleConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
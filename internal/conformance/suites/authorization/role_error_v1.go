@@ -31,6 +31,14 @@ func CreateRoleErrorV1TestSuite(globalTestSuite suites.GlobalTestSuite) *RoleErr
 		GlobalTestSuite: globalTestSuite,
 	}
 	suite.ScenarioName = constants.RoleErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Resources: []string{string(schema.GlobalTenantResourceMetadataKindResourceKindRole)},
+	}
 	return suite
 }
 
@@ -96,8 +104,7 @@ func (suite *RoleErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *RoleErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.AuthorizationProviderV1Name)
-	suite.ConfigureResources(t, string(schema.GlobalTenantResourceMetadataKindResourceKindRole))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -106,23 +113,27 @@ func (suite *RoleErrorV1TestSuite) TestScenario(t provider.T) {
 		"Create a role with non-existent provider in permission — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.InvalidProviderPermissionRole,
+		steps.ValidationError().At("/spec/permissions/0/provider"),
 	)
 
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with empty permissions list — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.EmptyPermissionsRole,
+		steps.ValidationError().At("/spec/permissions"),
 	)
 
 	stepsBuilder.CreateOrUpdateRoleExpectViolationV1Step(
 		"Create a role with invalid HTTP verb (CONNECT not allowed) — expect rejection",
 		suite.Client.AuthorizationV1,
 		suite.params.InvalidVerbRole,
+		steps.ValidationError().At("/spec/permissions/0/verb/0"),
 	)
 
 	suite.FinishScenario()
 }
 
 func (suite *RoleErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -1,7 +1,6 @@
 package authorization
 
 import (
-	"log/slog"
 	"net/http"
 
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/params"
@@ -29,6 +28,14 @@ func CreateRoleLifeCycleV1TestSuite(globalTestSuite suites.GlobalTestSuite) *Rol
 		GlobalTestSuite: globalTestSuite,
 	}
 	suite.ScenarioName = constants.RoleLifeCycleV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.LifeCycleKind,
+		Providers: []string{sdkconsts.AuthorizationProviderV1Name},
+		Resources: []string{string(schema.GlobalTenantResourceMetadataKindResourceKindRole)},
+	}
 	return suite
 }
 
@@ -88,14 +95,13 @@ func (suite *RoleLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 	suite.params = params
 	err = suites.SetupMockIfEnabled(suite.TestSuite, mockauthorization.ConfigureRoleLifecycleScenarioV1, *params)
 	if err != nil {
-		slog.Error("Failed to setup mock", "error", err)
+		suite.Logger().Error("Failed to setup mock", "error", err)
 		t.FailNow()
 	}
 }
 
 func (suite *RoleLifeCycleV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.AuthorizationProviderV1Name)
-	suite.ConfigureResources(t, string(schema.GlobalTenantResourceMetadataKindResourceKindRole))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -172,5 +178,6 @@ func (suite *RoleLifeCycleV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *RoleLifeCycleV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -51,6 +51,24 @@ func CreateInstanceConstraintsValidationV1TestSuite(regionalTestSuite suites.Reg
 		config:            config,
 	}
 	suite.ScenarioName = constants.InstanceConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+		},
+		Skus:  []string{sdkconsts.ComputeProviderV1Name, sdkconsts.StorageProviderV1Name},
+		Zones: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.ComputeProviderV1Name},
+		Resources: []string{string(schema.RegionalResourceMetadataKindResourceKindInstance)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+		},
+	}
 	return suite
 }
 
@@ -245,12 +263,7 @@ func (suite *InstanceConstraintsValidationV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *InstanceConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.ComputeProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalResourceMetadataKindResourceKindInstance))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -327,61 +340,73 @@ func (suite *InstanceConstraintsValidationV1TestSuite) TestScenario(t provider.T
 		"Create an instance with name exceeding maxLength:128 — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.OverLengthNameInstance,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.InvalidPatternNameInstance,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.OverLengthLabelValueInstance,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.OverLengthAnnotationInstance,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with userData exceeding maxLength:65536 — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.OverLengthUserDataInstance,
+		steps.ValidationError().At("/spec/userData"),
 	)
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with antiAffinityGroup exceeding maxLength:64 — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.OverLengthAntiAffinityGroupInstance,
+		steps.ValidationError().At("/spec/antiAffinityGroup"),
 	)
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with sshKey exceeding maxLength:4096 — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.OverLengthSshKeyInstance,
+		steps.ValidationError().At("/spec/sshKeys/0"),
 	)
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with sshKeys exceeding maxItems:32 — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.OverMaxItemsSshKeysInstance,
+		steps.ValidationError().At("/spec/sshKeys"),
 	)
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with empty sshKey value (minLength:1) — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.EmptySshKeyValueInstance,
+		steps.ValidationError().At("/spec/sshKeys/0"),
 	)
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with zone exceeding maxLength:32 — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.OverLengthZoneInstance,
+		steps.ValidationError().At("/spec/zone"),
 	)
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with empty zone (minLength:1) — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.EmptyZoneInstance,
+		steps.ValidationError().At("/spec/zone"),
 	)
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with dataVolumes exceeding maxItems:64 — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.OverMaxItemsDataVolumesInstance,
+		steps.ValidationError().At("/spec/dataVolumes"),
 	)
 
 	stepsBuilder.DeleteBlockStorageV1Step("Delete the blockstorage", t, suite.Client.StorageV1, block)
@@ -394,5 +419,6 @@ func (suite *InstanceConstraintsValidationV1TestSuite) TestScenario(t provider.T
 }
 
 func (suite *InstanceConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
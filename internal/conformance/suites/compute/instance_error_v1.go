@@ -18,7 +18,8 @@ import (
 )
 
 // InstanceErrorV1TestSuite verifies that Instance resources with invalid references
-// are rejected by the API with 422 Unprocessable Entity.
+// are rejected by the API with 422 Unprocessable Entity at the invalid field, or with
+// 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create instance with non-existent SKU
@@ -44,6 +45,24 @@ func CreateInstanceErrorV1TestSuite(regionalTestSuite suites.RegionalTestSuite,
 		config:            config,
 	}
 	suite.ScenarioName = constants.InstanceErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+		},
+		Skus:  []string{sdkconsts.ComputeProviderV1Name, sdkconsts.StorageProviderV1Name},
+		Zones: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.ComputeProviderV1Name},
+		Resources: []string{string(schema.RegionalResourceMetadataKindResourceKindInstance)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+		},
+	}
 	return suite
 }
 
@@ -166,12 +185,7 @@ func (suite *InstanceErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *InstanceErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.ComputeProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalResourceMetadataKindResourceKindInstance))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -242,29 +256,33 @@ func (suite *InstanceErrorV1TestSuite) TestScenario(t provider.T) {
 		},
 	)
 
-	// Error scenarios — all must be rejected with 422
+	// Error scenarios — rejected with 422, or 404 for a missing parent resource
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with non-existent SKU — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.InvalidSkuInstance,
+		steps.ValidationError().At("/spec/skuRef"),
 	)
 
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with non-existent workspace — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.NonExistentWorkspaceInstance,
+		steps.ResourceNotFoundError(),
 	)
 
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with non-existent boot volume ref — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.NonExistentBootVolumeInstance,
+		steps.ValidationError().At("/spec/bootVolume/deviceRef"),
 	)
 
 	stepsBuilder.CreateOrUpdateInstanceExpectViolationV1Step(
 		"Create an instance with invalid zone — expect rejection",
 		suite.Client.ComputeV1,
 		suite.params.InvalidZoneInstance,
+		steps.ValidationError().At("/spec/zone"),
 	)
 
 	// Power state error scenarios
@@ -318,15 +336,16 @@ func (suite *InstanceErrorV1TestSuite) TestScenario(t provider.T) {
 		},
 	)
 
-	// Start on already-on instance → 412
+	// Start on already-on instance → 409
 	stepsBuilder.InstanceOperationExpectConflictV1Step(
-		"Start an already-on instance — expect 412 conflict",
+		"Start an already-on instance — expect 409 conflict",
 		suite.Client.ComputeV1,
 		powerInstance,
 		func(ctx context.Context, r *schema.Instance) error {
 			return suite.Client.ComputeV1.StartInstance(ctx, r)
 		},
 		constants.StartInstanceOperation,
+		steps.ResourceConflictError(),
 	)
 	// Stopped instance: PUT  → powerState=off
 	stepsBuilder.StopInstanceV1Step("Stop the instance", suite.Client.ComputeV1, powerInstance)
@@ -340,6 +359,7 @@ func (suite *InstanceErrorV1TestSuite) TestScenario(t provider.T) {
 			return suite.Client.ComputeV1.StopInstance(ctx, r)
 		},
 		constants.StopInstanceOperation,
+		steps.ResourceConflictError(),
 	)
 
 	// Restart on already-off instance → 409
@@ -351,6 +371,7 @@ func (suite *InstanceErrorV1TestSuite) TestScenario(t provider.T) {
 			return suite.Client.ComputeV1.RestartInstance(ctx, r)
 		},
 		constants.RestartInstanceOperation,
+		steps.ResourceConflictError(),
 	)
 
 	// Teardown — reverse dependency order
@@ -367,5 +388,6 @@ func (suite *InstanceErrorV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *InstanceErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -36,6 +36,24 @@ func CreateProviderLifeCycleV1TestSuite(regionalTestSuite suites.RegionalTestSui
 		config:            config,
 	}
 	suite.ScenarioName = constants.ComputeProviderLifeCycleV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+		},
+		Skus:  []string{sdkconsts.ComputeProviderV1Name, sdkconsts.StorageProviderV1Name},
+		Zones: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.LifeCycleKind,
+		Providers: []string{sdkconsts.ComputeProviderV1Name},
+		Resources: []string{string(schema.RegionalResourceMetadataKindResourceKindInstance)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+		},
+	}
 	return suite
 }
 
@@ -155,12 +173,7 @@ func (suite *ProviderLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *ProviderLifeCycleV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.ComputeProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalResourceMetadataKindResourceKindInstance))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -360,5 +373,6 @@ func (suite *ProviderLifeCycleV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *ProviderLifeCycleV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
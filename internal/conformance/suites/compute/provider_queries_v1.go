@@ -37,6 +37,27 @@ func CreateProviderQueriesV1TestSuite(regionalTestSuite suites.RegionalTestSuite
 		config:            config,
 	}
 	suite.ScenarioName = constants.ComputeProviderQueriesV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+		},
+		Skus:  []string{sdkconsts.ComputeProviderV1Name, sdkconsts.StorageProviderV1Name},
+		Zones: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.QueriesKind,
+		Providers: []string{sdkconsts.ComputeProviderV1Name},
+		Resources: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindInstance),
+			string(schema.RegionalResourceMetadataKindResourceKindInstanceSku),
+		},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+		},
+	}
 	return suite
 }
 
@@ -174,14 +195,7 @@ func (suite *ProviderQueriesV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.ComputeProviderV1Name)
-	suite.ConfigureResources(t,
-		string(schema.RegionalResourceMetadataKindResourceKindInstance),
-		string(schema.RegionalResourceMetadataKindResourceKindInstanceSku),
-	)
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -238,14 +252,12 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List instances with label
 	stepsBuilder.ListInstanceV1Step("List instances with label", suite.Client.ComputeV1, wpath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()),
 		instanceExpects)
 
 	// List instances with limit and label
 	stepsBuilder.ListInstanceV1Step("List instances with limit and label", suite.Client.ComputeV1, wpath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		instanceExpects)
 
 	// Skus
@@ -307,5 +319,6 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *ProviderQueriesV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -10,11 +10,17 @@ const (
 	passed = "passed"
 
 	// Step Parameters
-	providerStepParameter  = "provider"
-	operationStepParameter = "operation"
-	tenantStepParameter    = "tenant"
-	workspaceStepParameter = "workspace"
-	networkStepParameter   = "network"
+	providerStepParameter    = "provider"
+	operationStepParameter   = "operation"
+	tenantStepParameter      = "tenant"
+	workspaceStepParameter   = "workspace"
+	networkStepParameter     = "network"
+	referenceStepParameter   = "reference"
+	diffStepParameter        = "diff"
+	pollsStepParameter       = "polls"
+	latencyStepParameter     = "latency_ms"
+	convergenceStepParameter = "convergence_ms"
+	thresholdStepParameter   = "threshold"
 
 	// Parent Suites
 	RegionParentSuite        = "Region"
@@ -0,0 +1,71 @@
+package suites
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+)
+
+// Resource Ledger
+
+type LedgerEntry struct {
+	Kind       string
+	Reference  string
+	DeleteFunc func(context.Context) error
+	WatchFunc  func(context.Context, secapi.ResourceObserverConfig) error
+}
+
+type ResourceLedger struct {
+	lock    sync.Mutex
+	entries []LedgerEntry
+}
+
+func NewResourceLedger() *ResourceLedger {
+	return &ResourceLedger{}
+}
+
+func (ledger *ResourceLedger) Register(entry LedgerEntry) {
+	ledger.lock.Lock()
+	defer ledger.lock.Unlock()
+
+	// Updates reuse the reference of the created resource
+	for i := range ledger.entries {
+		if ledger.entries[i].Reference == entry.Reference {
+			ledger.entries[i] = entry
+			return
+		}
+	}
+	ledger.entries = append(ledger.entries, entry)
+}
+
+func (ledger *ResourceLedger) Release(reference string) {
+	ledger.lock.Lock()
+	defer ledger.lock.Unlock()
+
+	ledger.entries = slices.DeleteFunc(ledger.entries, func(entry LedgerEntry) bool {
+		return entry.Reference == reference
+	})
+}
+
+func (ledger *ResourceLedger) Reset() {
+	ledger.lock.Lock()
+	defer ledger.lock.Unlock()
+
+	ledger.entries = nil
+}
+
+// Pending returns the registered entries in deletion order, most recently created first within a kind
+func (ledger *ResourceLedger) Pending() []LedgerEntry {
+	ledger.lock.Lock()
+	defer ledger.lock.Unlock()
+
+	pending := slices.Clone(ledger.entries)
+	slices.Reverse(pending)
+	slices.SortStableFunc(pending, func(a, b LedgerEntry) int {
+		return constants.ResourceDeletionRank(a.Kind) - constants.ResourceDeletionRank(b.Kind)
+	})
+	return pending
+}
@@ -0,0 +1,84 @@
+package suites
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/selector"
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+)
+
+// Suite Metadata
+
+// Metadata describes a suite and whether it would run with the current configuration, without running it
+type Metadata struct {
+	Name        string   `json:"name"`
+	ParentSuite string   `json:"parent_suite"`
+	Category    string   `json:"category"`
+	Level       string   `json:"level"`
+	Providers   []string `json:"providers"`
+	Inputs      []string `json:"inputs,omitempty"`
+	Resources   []string `json:"resources,omitempty"`
+	Depends     []string `json:"depends,omitempty"`
+	Selected    bool     `json:"selected"`
+	Runnable    bool     `json:"runnable"`
+	Unmet       []string `json:"unmet,omitempty"`
+}
+
+func (suite *TestSuite) Metadata(selector *selector.Selector) Metadata {
+	// Scenario names start with the parent suite, e.g. Compute.V1.ProviderLifeCycle
+	parentSuite, _, _ := strings.Cut(suite.ScenarioName, ".")
+
+	unmet := suite.Requirements.unmet(suite.params, suite.clients)
+	return Metadata{
+		Name:        suite.ScenarioName,
+		ParentSuite: parentSuite,
+		Category:    suite.Tags.Kind,
+		Level:       constants.SuiteLevel(suite.ScenarioName).String(),
+		Providers:   suite.Requirements.Providers,
+		Inputs:      suite.Requirements.inputs(),
+		Resources:   suite.Tags.Resources,
+		Depends:     suite.Tags.Depends,
+		Selected:    suite.selected(selector),
+		Runnable:    len(unmet) == 0,
+		Unmet:       unmet,
+	}
+}
+
+// Status summarizes what the run would do with the suite
+func (metadata Metadata) Status() string {
+	switch {
+	case !metadata.Selected:
+		return "filtered"
+	case !metadata.Runnable:
+		return "skip: " + strings.Join(metadata.Unmet, "; ")
+	default:
+		return "run"
+	}
+}
+
+func WriteMetadataTable(w io.Writer, list []Metadata) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "SUITE\tPARENT\tCATEGORY\tLEVEL\tPROVIDERS\tINPUTS\tRESOURCES\tSTATUS"); err != nil {
+		return err
+	}
+	for _, metadata := range list {
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			metadata.Name, metadata.ParentSuite, metadata.Category, metadata.Level,
+			joinOrDash(metadata.Providers), joinOrDash(metadata.Inputs), joinOrDash(metadata.Resources),
+			metadata.Status(),
+		); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func joinOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ",")
+}
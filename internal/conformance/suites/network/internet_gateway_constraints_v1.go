@@ -36,6 +36,15 @@ func CreateInternetGatewayConstraintsValidationV1TestSuite(regionalTestSuite sui
 		RegionalTestSuite: regionalTestSuite,
 	}
 	suite.ScenarioName = constants.InternetGatewayConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindInternetGateway)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -98,9 +107,7 @@ func (suite *InternetGatewayConstraintsValidationV1TestSuite) BeforeAll(t provid
 }
 
 func (suite *InternetGatewayConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindInternetGateway))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -142,21 +149,25 @@ func (suite *InternetGatewayConstraintsValidationV1TestSuite) TestScenario(t pro
 		"Create an internet gateway with name exceeding maxLength:128 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthNameInternetGateway,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateInternetGatewayExpectViolationV1Step(
 		"Create an internet gateway with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidPatternNameInternetGateway,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateInternetGatewayExpectViolationV1Step(
 		"Create an internet gateway with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthLabelValueInternetGateway,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdateInternetGatewayExpectViolationV1Step(
 		"Create an internet gateway with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthAnnotationInternetGateway,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 
 	stepsBuilder.DeleteWorkspaceV1Step("Delete the workspace", t, suite.Client.WorkspaceV1, workspace)
@@ -166,5 +177,6 @@ func (suite *InternetGatewayConstraintsValidationV1TestSuite) TestScenario(t pro
 }
 
 func (suite *InternetGatewayConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
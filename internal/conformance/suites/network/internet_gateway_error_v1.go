@@ -15,7 +15,8 @@ import (
 )
 
 // InternetGatewayErrorV1TestSuite verifies that InternetGateway resources with
-// invalid references are rejected by the API with 422 Unprocessable Entity.
+// invalid references are rejected by the API with 422 Unprocessable Entity at the
+// invalid field, or with 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create internet gateway with invalid region
@@ -30,6 +31,15 @@ func CreateInternetGatewayErrorV1TestSuite(regionalTestSuite suites.RegionalTest
 		RegionalTestSuite: regionalTestSuite,
 	}
 	suite.ScenarioName = constants.InternetGatewayErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindInternetGateway)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -89,9 +99,7 @@ func (suite *InternetGatewayErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *InternetGatewayErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindInternetGateway))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -127,17 +135,19 @@ func (suite *InternetGatewayErrorV1TestSuite) TestScenario(t provider.T) {
 		},
 	)
 
-	// Error scenarios — all must be rejected with 422
+	// Error scenarios — rejected with 422, or 404 for a missing parent resource
 	stepsBuilder.CreateOrUpdateInternetGatewayExpectViolationV1Step(
 		"Create an internet gateway with invalid region — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidRegionInternetGateway,
+		steps.ValidationError().At("/metadata/region"),
 	)
 
 	stepsBuilder.CreateOrUpdateInternetGatewayExpectViolationV1Step(
 		"Create an internet gateway with non-existent workspace — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentWorkspaceInternetGateway,
+		steps.ResourceNotFoundError(),
 	)
 
 	// Teardown
@@ -148,5 +158,6 @@ func (suite *InternetGatewayErrorV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *InternetGatewayErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -25,6 +25,15 @@ func CreateInternetGatewayLifeCycleV1TestSuite(regionalTestSuite suites.Regional
 		RegionalTestSuite: regionalTestSuite,
 	}
 	suite.ScenarioName = constants.InternetGatewayLifeCycleV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.LifeCycleKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindInternetGateway)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -96,9 +105,7 @@ func (suite *InternetGatewayLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *InternetGatewayLifeCycleV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindInternetGateway))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -213,5 +220,6 @@ func (suite *InternetGatewayLifeCycleV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *InternetGatewayLifeCycleV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -43,6 +43,17 @@ func CreateNetworkConstraintsValidationV1TestSuite(regionalTestSuite suites.Regi
 		config:            config,
 	}
 	suite.ScenarioName = constants.NetworkConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:   []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		Skus:        []string{sdkconsts.NetworkProviderV1Name},
+		NetworkCidr: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNetwork)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -185,11 +196,7 @@ func (suite *NetworkConstraintsValidationV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *NetworkConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNetwork))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-	)
+	suite.StartScenario(t)
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
 	// Workspace
@@ -231,66 +238,79 @@ func (suite *NetworkConstraintsValidationV1TestSuite) TestScenario(t provider.T)
 		"Create a network with name exceeding maxLength:128 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthNameNetwork,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidPatternNameNetwork,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthLabelValueNetwork,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthAnnotationNetwork,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with cidr.ipv4 exceeding maxLength:18 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthCidrIpv4Network,
+		steps.ValidationError().At("/spec/cidr/ipv4"),
 	)
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with cidr.ipv4 below minLength:9 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.UnderLengthCidrIpv4Network,
+		steps.ValidationError().At("/spec/cidr/ipv4"),
 	)
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with cidr.ipv6 exceeding maxLength:43 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthCidrIpv6Network,
+		steps.ValidationError().At("/spec/cidr/ipv6"),
 	)
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with cidr.ipv6 below minLength:4 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.UnderLengthCidrIpv6Network,
+		steps.ValidationError().At("/spec/cidr/ipv6"),
 	)
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with additionalCidrs[].ipv4 exceeding maxLength:18 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthAdditionalCidrIpv4Network,
+		steps.ValidationError().At("/spec/additionalCidrs/0/ipv4"),
 	)
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with additionalCidrs[].ipv6 exceeding maxLength:43 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthAdditionalCidrIpv6Network,
+		steps.ValidationError().At("/spec/additionalCidrs/0/ipv6"),
 	)
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with additionalCidrs[].ipv4 below minLength:9 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.UnderLengthAdditionalCidrIpv4Network,
+		steps.ValidationError().At("/spec/additionalCidrs/0/ipv4"),
 	)
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with additionalCidrs[].ipv6 below minLength:4 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.UnderLengthAdditionalCidrIpv6Network,
+		steps.ValidationError().At("/spec/additionalCidrs/0/ipv6"),
 	)
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with additionalCidrs exceeding maxItems:32 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverMaxItemsAdditionalCidrsNetwork,
+		steps.ValidationError().At("/spec/additionalCidrs"),
 	)
 
 	stepsBuilder.DeleteWorkspaceV1Step("Delete the workspace", t, suite.Client.WorkspaceV1, workspace)
@@ -300,5 +320,6 @@ func (suite *NetworkConstraintsValidationV1TestSuite) TestScenario(t provider.T)
 }
 
 func (suite *NetworkConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
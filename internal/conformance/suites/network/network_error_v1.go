@@ -17,8 +17,9 @@ import (
 )
 
 // NetworkErrorV1TestSuite verifies that Network resources with invalid references
-// are rejected by the API with 422 Unprocessable Entity, and that operations conflicting
-// with the current state of a network are rejected with 409 Conflict.
+// are rejected by the API with 422 Unprocessable Entity at the invalid field, or with
+// 404 Not Found when a parent resource in the path does not exist, and that operations
+// conflicting with the current state of a network are rejected with 409 Conflict.
 //
 // Scenarios tested:
 //   - Create network with invalid region
@@ -43,6 +44,21 @@ func CreateNetworkErrorV1TestSuite(regionalTestSuite suites.RegionalTestSuite, c
 		config:            config,
 	}
 	suite.ScenarioName = constants.NetworkErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:   []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		Skus:        []string{sdkconsts.NetworkProviderV1Name},
+		NetworkCidr: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNetwork)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
+		},
+	}
 	return suite
 }
 
@@ -156,13 +172,7 @@ func (suite *NetworkErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *NetworkErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNetwork))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -198,23 +208,26 @@ func (suite *NetworkErrorV1TestSuite) TestScenario(t provider.T) {
 		},
 	)
 
-	// Error scenarios — all must be rejected with 422
+	// Error scenarios — rejected with 422, or 404 for a missing parent resource
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with invalid region — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidRegionNetwork,
+		steps.ValidationError().At("/metadata/region"),
 	)
 
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with invalid SKU — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidSkuNetwork,
+		steps.ValidationError().At("/spec/skuRef"),
 	)
 
 	stepsBuilder.CreateOrUpdateNetworkExpectViolationV1Step(
 		"Create a network with non-existent workspace — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentWorkspaceNetwork,
+		steps.ResourceNotFoundError(),
 	)
 
 	// Delete conflict scenario — network with an active route table must not be deletable
@@ -326,6 +339,7 @@ func (suite *NetworkErrorV1TestSuite) TestScenario(t provider.T) {
 		"Delete a network with an active route table — expect 409 conflict",
 		suite.Client.NetworkV1,
 		network,
+		steps.ResourceConflictError(),
 	)
 
 	// Teardown — reverse dependency order
@@ -346,5 +360,6 @@ func (suite *NetworkErrorV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *NetworkErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
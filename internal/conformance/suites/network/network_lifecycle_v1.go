@@ -34,6 +34,21 @@ func CreateNetworkLifeCycleV1TestSuite(regionalTestSuite suites.RegionalTestSuit
 		config:            config,
 	}
 	suite.ScenarioName = constants.NetworkLifeCycleV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:   []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		Skus:        []string{sdkconsts.NetworkProviderV1Name},
+		NetworkCidr: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.LifeCycleKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNetwork)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+			string(schema.RegionalResourceMetadataKindResourceKindRoutingTable),
+		},
+	}
 	return suite
 }
 
@@ -155,13 +170,7 @@ func (suite *NetworkLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *NetworkLifeCycleV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNetwork))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
-		string(schema.RegionalResourceMetadataKindResourceKindRoutingTable),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -358,5 +367,6 @@ func (suite *NetworkLifeCycleV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *NetworkLifeCycleV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
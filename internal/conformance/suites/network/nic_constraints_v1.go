@@ -39,6 +39,16 @@ func CreateNicConstraintsValidationV1TestSuite(regionalTestSuite suites.Regional
 		config:            config,
 	}
 	suite.ScenarioName = constants.NicConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:   []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		NetworkCidr: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNic)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -49,7 +59,7 @@ func (suite *NicConstraintsValidationV1TestSuite) BeforeAll(t provider.T) {
 	networkName := generators.GenerateNetworkName()
 	subnetName := generators.GenerateSubnetName()
 
-	subnetCidr, err := generators.GenerateSubnetCidr(suite.config.NetworkCidr, 8, 1)
+	subnetCidr, err := generators.AllocateSubnetCidr(suite.config.NetworkCidr, 8)
 	if err != nil {
 		t.Fatalf("Failed to generate subnet cidr: %v", err)
 	}
@@ -140,11 +150,7 @@ func (suite *NicConstraintsValidationV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *NicConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNic))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-	)
+	suite.StartScenario(t)
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
 	// Workspace
@@ -185,26 +191,31 @@ func (suite *NicConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
 		"Create a nic with name exceeding maxLength:128 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthNameNic,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateNicExpectViolationV1Step(
 		"Create a nic with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidPatternNameNic,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateNicExpectViolationV1Step(
 		"Create a nic with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthLabelValueNic,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdateNicExpectViolationV1Step(
 		"Create a nic with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthAnnotationNic,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 	stepsBuilder.CreateOrUpdateNicExpectViolationV1Step(
 		"Create a nic with address exceeding maxLength:39 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthAddressNic,
+		steps.ValidationError().At("/spec/addresses/0"),
 	)
 
 	stepsBuilder.DeleteWorkspaceV1Step("Delete the workspace", t, suite.Client.WorkspaceV1, workspace)
@@ -214,5 +225,6 @@ func (suite *NicConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *NicConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
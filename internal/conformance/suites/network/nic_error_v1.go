@@ -17,7 +17,8 @@ import (
 )
 
 // NicErrorV1TestSuite verifies that Nic resources with invalid references
-// are rejected by the API with 422 Unprocessable Entity.
+// are rejected by the API with 422 Unprocessable Entity at the invalid field, or with
+// 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create nic with invalid region
@@ -37,6 +38,24 @@ func CreateNicErrorV1TestSuite(regionalTestSuite suites.RegionalTestSuite, confi
 		config:            config,
 	}
 	suite.ScenarioName = constants.NicErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:   []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		Skus:        []string{sdkconsts.NetworkProviderV1Name},
+		Zones:       true,
+		NetworkCidr: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNic)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindNetwork),
+			string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
+			string(schema.RegionalResourceMetadataKindResourceKindSubnet),
+		},
+	}
 	return suite
 }
 
@@ -51,7 +70,7 @@ func (suite *NicErrorV1TestSuite) BeforeAll(t provider.T) {
 	networkSkuName := suite.config.NetworkSkus[rand.Intn(len(suite.config.NetworkSkus))]
 	zone := suite.config.RegionZones[rand.Intn(len(suite.config.RegionZones))]
 
-	subnetCidr, err := generators.GenerateSubnetCidr(suite.config.NetworkCidr, 8, 1)
+	subnetCidr, err := generators.AllocateSubnetCidr(suite.config.NetworkCidr, 8)
 	if err != nil {
 		t.Fatalf("Failed to generate subnet cidr: %v", err)
 	}
@@ -204,15 +223,7 @@ func (suite *NicErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *NicErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNic))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindNetwork),
-		string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
-		string(schema.RegionalResourceMetadataKindResourceKindSubnet),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -391,29 +402,33 @@ func (suite *NicErrorV1TestSuite) TestScenario(t provider.T) {
 		},
 	)
 
-	// Error scenarios — all must be rejected with 422
+	// Error scenarios — rejected with 422, or 404 for a missing parent resource
 	stepsBuilder.CreateOrUpdateNicExpectViolationV1Step(
 		"Create a nic with invalid region — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidRegionNic,
+		steps.ValidationError().At("/metadata/region"),
 	)
 
 	stepsBuilder.CreateOrUpdateNicExpectViolationV1Step(
 		"Create a nic with non-existent workspace — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentWorkspaceNic,
+		steps.ResourceNotFoundError(),
 	)
 
 	stepsBuilder.CreateOrUpdateNicExpectViolationV1Step(
 		"Create a nic with non-existent subnetRef — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentSubnetRefNic,
+		steps.ValidationError().At("/spec/subnetRef"),
 	)
 
 	stepsBuilder.CreateOrUpdateNicExpectViolationV1Step(
 		"Create a nic with non-existent publicIpRef — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentPublicIpRefNic,
+		steps.ValidationError().At("/spec/publicIpRefs/0"),
 	)
 
 	// Teardown — reverse dependency order
@@ -436,5 +451,6 @@ func (suite *NicErrorV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *NicErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
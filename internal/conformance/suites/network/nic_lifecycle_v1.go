@@ -36,6 +36,24 @@ func CreateNicLifeCycleV1TestSuite(regionalTestSuite suites.RegionalTestSuite, c
 		config:            config,
 	}
 	suite.ScenarioName = constants.NicLifeCycleV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:   []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		Skus:        []string{sdkconsts.NetworkProviderV1Name},
+		Zones:       true,
+		NetworkCidr: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.LifeCycleKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNic)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindNetwork),
+			string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
+			string(schema.RegionalResourceMetadataKindResourceKindSubnet),
+		},
+	}
 	return suite
 }
 
@@ -52,7 +70,7 @@ func (suite *NicLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 
 	zone1 := suite.config.RegionZones[rand.Intn(len(suite.config.RegionZones))]
 
-	subnetCidr, err := generators.GenerateSubnetCidr(suite.config.NetworkCidr, 8, 1)
+	subnetCidr, err := generators.AllocateSubnetCidr(suite.config.NetworkCidr, 8)
 	if err != nil {
 		t.Fatalf("Failed to generate subnet cidr: %v", err)
 	}
@@ -215,15 +233,7 @@ func (suite *NicLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *NicLifeCycleV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNic))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindNetwork),
-		string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
-		string(schema.RegionalResourceMetadataKindResourceKindSubnet),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -495,5 +505,6 @@ func (suite *NicLifeCycleV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *NicLifeCycleV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
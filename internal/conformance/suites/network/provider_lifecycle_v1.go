@@ -39,6 +39,40 @@ func CreateProviderLifeCycleV1TestSuite(regionalTestSuite suites.RegionalTestSui
 		config:            config,
 	}
 	suite.ScenarioName = constants.NetworkProviderLifeCycleV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.NetworkProviderV1Name,
+		},
+		Skus: []string{
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.NetworkProviderV1Name,
+		},
+		Zones:          true,
+		NetworkCidr:    true,
+		PublicIpsRange: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.LifeCycleKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNetwork),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindInternetGateway),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNic),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindPublicIP),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindSubnet),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroup),
+		},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+			string(schema.RegionalResourceMetadataKindResourceKindInstance),
+		},
+	}
 	return suite
 }
 
@@ -46,7 +80,7 @@ func (suite *ProviderLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 	t.AddParentSuite(suites.NetworkParentSuite)
 
 	// Generate the subnet cidr
-	subnetCidr, err := generators.GenerateSubnetCidr(suite.config.NetworkCidr, 8, 1)
+	subnetCidr, err := generators.AllocateSubnetCidr(suite.config.NetworkCidr, 8)
 	if err != nil {
 		t.Fatalf("Failed to generate subnet cidr: %v", err)
 	}
@@ -62,11 +96,11 @@ func (suite *ProviderLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 	}
 
 	// Generate the public ips
-	publicIpAddress1, err := generators.GeneratePublicIp(suite.config.PublicIpsRange, 1)
+	publicIpAddress1, err := generators.AllocatePublicIp(suite.config.PublicIpsRange)
 	if err != nil {
 		t.Fatalf("Failed to generate public ip: %v", err)
 	}
-	publicIpAddress2, err := generators.GeneratePublicIp(suite.config.PublicIpsRange, 2)
+	publicIpAddress2, err := generators.AllocatePublicIp(suite.config.PublicIpsRange)
 	if err != nil {
 		t.Fatalf("Failed to generate public ip: %v", err)
 	}
@@ -490,20 +524,7 @@ func (suite *ProviderLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *ProviderLifeCycleV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNetwork),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindInternetGateway),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNic),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindPublicIP),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindSubnet),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroup),
-	)
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
-		string(schema.RegionalResourceMetadataKindResourceKindInstance),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -1187,5 +1208,6 @@ func (suite *ProviderLifeCycleV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *ProviderLifeCycleV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -13,7 +13,6 @@ import (
 	sdkconsts "github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
 	"github.com/eu-sovereign-cloud/go-sdk/secapi"
-	labelBuilder "github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
 
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 )
@@ -40,6 +39,37 @@ func CreateProviderQueriesV1TestSuite(regionalTestSuite suites.RegionalTestSuite
 		config:            config,
 	}
 	suite.ScenarioName = constants.NetworkProviderQueriesV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		Skus: []string{
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.NetworkProviderV1Name,
+		},
+		Zones:          true,
+		NetworkCidr:    true,
+		PublicIpsRange: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.QueriesKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNetwork),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNetworkSku),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindInternetGateway),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNic),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindPublicIP),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindSubnet),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroup),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroupRule),
+		},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+			string(schema.RegionalResourceMetadataKindResourceKindInstance),
+		},
+	}
 	return suite
 }
 
@@ -47,7 +77,7 @@ func (suite *ProviderQueriesV1TestSuite) BeforeAll(t provider.T) {
 	t.AddParentSuite(suites.NetworkParentSuite)
 
 	// Generate the subnet cidr
-	subnetCidr, err := generators.GenerateSubnetCidr(suite.config.NetworkCidr, 8, 1)
+	subnetCidr, err := generators.AllocateSubnetCidr(suite.config.NetworkCidr, 8)
 	if err != nil {
 		t.Fatalf("Failed to generate subnet cidr: %v", err)
 	}
@@ -59,7 +89,7 @@ func (suite *ProviderQueriesV1TestSuite) BeforeAll(t provider.T) {
 	}
 
 	// Generate the public ips
-	publicIpAddress1, err := generators.GeneratePublicIp(suite.config.PublicIpsRange, 1)
+	publicIpAddress1, err := generators.AllocatePublicIp(suite.config.PublicIpsRange)
 	if err != nil {
 		t.Fatalf("Failed to generate public ip: %v", err)
 	}
@@ -501,21 +531,7 @@ func (suite *ProviderQueriesV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNetwork),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNetworkSku),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindInternetGateway),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindNic),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindPublicIP),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindSubnet),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroup),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroupRule),
-	)
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
-		string(schema.RegionalResourceMetadataKindResourceKindInstance),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -557,13 +573,11 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List networks with label
 	stepsBuilder.ListNetworkV1Step("List networks with label", suite.Client.NetworkV1, wpath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)), networkExpects)
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()), networkExpects)
 
 	// List networks with limit and label
 	stepsBuilder.ListNetworkV1Step("List networks with limit and label", suite.Client.NetworkV1, wpath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)), networkExpects)
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()), networkExpects)
 
 	// Skus
 
@@ -607,14 +621,12 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List internet gateways with label
 	stepsBuilder.ListInternetGatewayV1Step("List internet gateways with label", suite.Client.NetworkV1, wpath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()),
 		internetGatewayExpects)
 
 	// List internet gateways with limit and label
 	stepsBuilder.ListInternetGatewayV1Step("List internet gateways with limit and label", suite.Client.NetworkV1, wpath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		internetGatewayExpects)
 
 	// Route table
@@ -643,14 +655,12 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List route tables with label
 	stepsBuilder.ListRouteTableV1Step("List route tables with label", suite.Client.NetworkV1, npath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()),
 		routeTableExpects)
 
 	// List route tables with limit and label
 	stepsBuilder.ListRouteTableV1Step("List route tables with limit and label", suite.Client.NetworkV1, npath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		routeTableExpects)
 
 	// Subnet
@@ -673,14 +683,12 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List subnets with label
 	stepsBuilder.ListSubnetV1Step("List subnets with label", suite.Client.NetworkV1, npath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()),
 		subnetExpects)
 
 	// List subnets with limit and label
 	stepsBuilder.ListSubnetV1Step("List subnets with limit and label", suite.Client.NetworkV1, npath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		subnetExpects)
 
 	// Public ip
@@ -703,14 +711,12 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List public ips with label
 	stepsBuilder.ListPublicIpV1Step("List public ips with label", suite.Client.NetworkV1, wpath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()),
 		publicIpExpects)
 
 	// List public ips with limit and label
 	stepsBuilder.ListPublicIpV1Step("List public ips with limit and label", suite.Client.NetworkV1, wpath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		publicIpExpects)
 
 	// Nic
@@ -733,14 +739,12 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List nics with label
 	stepsBuilder.ListNicV1Step("List nics with label", suite.Client.NetworkV1, wpath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()),
 		nicExpects)
 
 	// List nics with limit and label
 	stepsBuilder.ListNicV1Step("List nics with limit and label", suite.Client.NetworkV1, wpath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		nicExpects)
 
 	// Security Group Rule
@@ -763,14 +767,12 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List security group rules with label
 	stepsBuilder.ListSecurityGroupRuleV1Step("List security group rules with label", suite.Client.NetworkV1, wpath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()),
 		securityGroupRuleExpects)
 
 	// List security group rules with limit and label
 	stepsBuilder.ListSecurityGroupRuleV1Step("List security group rules with limit and label", suite.Client.NetworkV1, wpath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		securityGroupRuleExpects)
 
 	// Security Group
@@ -793,14 +795,12 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List security groups with label
 	stepsBuilder.ListSecurityGroupV1Step("List security groups with label", suite.Client.NetworkV1, wpath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()),
 		securityGroupExpects)
 
 	// List security groups with limit and label
 	stepsBuilder.ListSecurityGroupV1Step("List security groups with limit and label", suite.Client.NetworkV1, wpath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		securityGroupExpects)
 
 	// Delete all security group rules
@@ -841,5 +841,6 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *ProviderQueriesV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -39,6 +39,16 @@ func CreatePublicIpConstraintsValidationV1TestSuite(regionalTestSuite suites.Reg
 		config:            config,
 	}
 	suite.ScenarioName = constants.PublicIpConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:      []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		PublicIpsRange: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalNetworkResourceMetadataKindResourceKindPublicIP)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -47,7 +57,7 @@ func (suite *PublicIpConstraintsValidationV1TestSuite) BeforeAll(t provider.T) {
 
 	workspaceName := generators.GenerateWorkspaceName()
 
-	publicIpAddress, err := generators.GeneratePublicIp(suite.config.PublicIpsRange, 1)
+	publicIpAddress, err := generators.AllocatePublicIp(suite.config.PublicIpsRange)
 	if err != nil {
 		t.Fatalf("Failed to generate public ip: %v", err)
 	}
@@ -130,9 +140,7 @@ func (suite *PublicIpConstraintsValidationV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *PublicIpConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalNetworkResourceMetadataKindResourceKindPublicIP))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -175,26 +183,31 @@ func (suite *PublicIpConstraintsValidationV1TestSuite) TestScenario(t provider.T
 		"Create a public ip with name exceeding maxLength:128 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthNamePublicIp,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdatePublicIpExpectViolationV1Step(
 		"Create a public ip with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidPatternNamePublicIp,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdatePublicIpExpectViolationV1Step(
 		"Create a public ip with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthLabelValuePublicIp,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdatePublicIpExpectViolationV1Step(
 		"Create a public ip with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthAnnotationPublicIp,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 	stepsBuilder.CreateOrUpdatePublicIpExpectViolationV1Step(
 		"Create a public ip with address exceeding maxLength:39 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthAddressPublicIp,
+		steps.ValidationError().At("/spec/address"),
 	)
 
 	stepsBuilder.DeleteWorkspaceV1Step("Delete the workspace", t, suite.Client.WorkspaceV1, workspace)
@@ -204,5 +217,6 @@ func (suite *PublicIpConstraintsValidationV1TestSuite) TestScenario(t provider.T
 }
 
 func (suite *PublicIpConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -15,7 +15,8 @@ import (
 )
 
 // PublicIpErrorV1TestSuite verifies that PublicIp resources with
-// invalid references are rejected by the API with 422 Unprocessable Entity.
+// invalid references are rejected by the API with 422 Unprocessable Entity at the
+// invalid field, or with 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create public ip with invalid region
@@ -35,6 +36,15 @@ func CreatePublicIpErrorV1TestSuite(regionalTestSuite suites.RegionalTestSuite,
 	}
 
 	suite.ScenarioName = constants.PublicIpErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindPublicIP)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -106,9 +116,7 @@ func (suite *PublicIpErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *PublicIpErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindPublicIP))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -144,23 +152,26 @@ func (suite *PublicIpErrorV1TestSuite) TestScenario(t provider.T) {
 		},
 	)
 
-	// Error scenarios — all must be rejected with 422
+	// Error scenarios — rejected with 422, or 404 for a missing parent resource
 	stepsBuilder.CreateOrUpdatePublicIpExpectViolationV1Step(
 		"Create a public ip with invalid region — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidRegionPublicIp,
+		steps.ValidationError().At("/metadata/region"),
 	)
 
 	stepsBuilder.CreateOrUpdatePublicIpExpectViolationV1Step(
 		"Create a public ip with non-existent workspace — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentWorkspacePublicIp,
+		steps.ResourceNotFoundError(),
 	)
 
 	stepsBuilder.CreateOrUpdatePublicIpExpectViolationV1Step(
 		"Create a public ip with invalid IP version (IPv5 not in [IPv4, IPv6]) — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidVersionPublicIp,
+		steps.ValidationError().At("/spec/version"),
 	)
 
 	// Teardown
@@ -171,5 +182,6 @@ func (suite *PublicIpErrorV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *PublicIpErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
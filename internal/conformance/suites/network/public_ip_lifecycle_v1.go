@@ -33,6 +33,16 @@ func CreatePublicIpLifeCycleV1TestSuite(regionalTestSuite suites.RegionalTestSui
 		config:            config,
 	}
 	suite.ScenarioName = constants.PublicIpLifeCycleV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:      []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		PublicIpsRange: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.LifeCycleKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalNetworkResourceMetadataKindResourceKindPublicIP)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -43,11 +53,11 @@ func (suite *PublicIpLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 	publicIpName := generators.GeneratePublicIpName()
 
 	// Generate the public ips
-	publicIpAddress1, err := generators.GeneratePublicIp(suite.config.PublicIpsRange, 1)
+	publicIpAddress1, err := generators.AllocatePublicIp(suite.config.PublicIpsRange)
 	if err != nil {
 		t.Fatalf("Failed to generate public ip: %v", err)
 	}
-	publicIpAddress2, err := generators.GeneratePublicIp(suite.config.PublicIpsRange, 2)
+	publicIpAddress2, err := generators.AllocatePublicIp(suite.config.PublicIpsRange)
 	if err != nil {
 		t.Fatalf("Failed to generate public ip: %v", err)
 	}
@@ -116,9 +126,7 @@ func (suite *PublicIpLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *PublicIpLifeCycleV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalNetworkResourceMetadataKindResourceKindPublicIP))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -233,5 +241,6 @@ func (suite *PublicIpLifeCycleV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *PublicIpLifeCycleV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -40,6 +40,21 @@ func CreateRouteTableConstraintsValidationV1TestSuite(regionalTestSuite suites.R
 		config:            config,
 	}
 	suite.ScenarioName = constants.RouteTableConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:   []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		Skus:        []string{sdkconsts.NetworkProviderV1Name},
+		NetworkCidr: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindNetwork),
+			string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+		},
+	}
 	return suite
 }
 
@@ -180,13 +195,7 @@ func (suite *RouteTableConstraintsValidationV1TestSuite) BeforeAll(t provider.T)
 }
 
 func (suite *RouteTableConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindNetwork),
-		string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
-	)
+	suite.StartScenario(t)
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
 	// Workspace
@@ -302,36 +311,43 @@ func (suite *RouteTableConstraintsValidationV1TestSuite) TestScenario(t provider
 		"Create a route table with name exceeding maxLength:128 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthNameRouteTable,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateRouteTableExpectViolationV1Step(
 		"Create a route table with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidPatternNameRouteTable,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateRouteTableExpectViolationV1Step(
 		"Create a route table with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthLabelValueRouteTable,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdateRouteTableExpectViolationV1Step(
 		"Create a route table with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthAnnotationRouteTable,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 	stepsBuilder.CreateOrUpdateRouteTableExpectViolationV1Step(
 		"Create a route table with routes[].destinationCidrBlock exceeding maxLength:43 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthDestinationCidrBlockRouteTable,
+		steps.ValidationError().At("/spec/routes/0/destinationCidrBlock"),
 	)
 	stepsBuilder.CreateOrUpdateRouteTableExpectViolationV1Step(
 		"Create a route table with routes empty (minItems:1) — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.EmptyRoutesRouteTable,
+		steps.ValidationError().At("/spec/routes"),
 	)
 	stepsBuilder.CreateOrUpdateRouteTableExpectViolationV1Step(
 		"Create a route table with routes exceeding maxItems:1000 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverMaxItemsRoutesRouteTable,
+		steps.ValidationError().At("/spec/routes"),
 	)
 
 	stepsBuilder.DeleteInternetGatewayV1Step("Delete the internet gateway", t, suite.Client.NetworkV1, internetGat)
@@ -347,5 +363,6 @@ func (suite *RouteTableConstraintsValidationV1TestSuite) TestScenario(t provider
 }
 
 func (suite *RouteTableConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -17,7 +17,8 @@ import (
 )
 
 // RouteTableErrorV1TestSuite verifies that RouteTable resources with invalid references
-// are rejected by the API with 422 Unprocessable Entity.
+// are rejected by the API with 422 Unprocessable Entity at the invalid field, or with
+// 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create route table with invalid region
@@ -42,6 +43,21 @@ func CreateRouteTableErrorV1TestSuite(regionalTestSuite suites.RegionalTestSuite
 		config:            config,
 	}
 	suite.ScenarioName = constants.RouteTableErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:   []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		Skus:        []string{sdkconsts.NetworkProviderV1Name},
+		NetworkCidr: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindNetwork),
+			string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+		},
+	}
 	return suite
 }
 
@@ -161,13 +177,7 @@ func (suite *RouteTableErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *RouteTableErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindNetwork),
-		string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -273,29 +283,33 @@ func (suite *RouteTableErrorV1TestSuite) TestScenario(t provider.T) {
 		},
 	)
 
-	// Error scenarios — all must be rejected with 422
+	// Error scenarios — rejected with 422, or 404 for a missing parent resource
 	stepsBuilder.CreateOrUpdateRouteTableExpectViolationV1Step(
 		"Create a route table with invalid region — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidRegionRouteTable,
+		steps.ValidationError().At("/metadata/region"),
 	)
 
 	stepsBuilder.CreateOrUpdateRouteTableExpectViolationV1Step(
 		"Create a route table with non-existent workspace — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentWorkspaceRouteTable,
+		steps.ResourceNotFoundError(),
 	)
 
 	stepsBuilder.CreateOrUpdateRouteTableExpectViolationV1Step(
 		"Create a route table with non-existent network — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentNetworkRouteTable,
+		steps.ResourceNotFoundError(),
 	)
 
 	stepsBuilder.CreateOrUpdateRouteTableExpectViolationV1Step(
 		"Create a route table with non-existent targetRef — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentTargetRefRouteTable,
+		steps.ValidationError().At("/spec/routes/0/targetRef"),
 	)
 
 	// Teardown — reverse dependency order
@@ -312,5 +326,6 @@ func (suite *RouteTableErrorV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *RouteTableErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -30,6 +30,21 @@ func CreateRouteTableLifeCycleV1TestSuite(regionalTestSuite suites.RegionalTestS
 		config:            config,
 	}
 	suite.ScenarioName = constants.RouteTableLifeCycleV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:   []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		Skus:        []string{sdkconsts.NetworkProviderV1Name},
+		NetworkCidr: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.LifeCycleKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindNetwork),
+			string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+		},
+	}
 	return suite
 }
 
@@ -163,13 +178,7 @@ func (suite *RouteTableLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *RouteTableLifeCycleV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindNetwork),
-		string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -368,5 +377,6 @@ func (suite *RouteTableLifeCycleV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *RouteTableLifeCycleV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -44,6 +44,15 @@ func CreateSecurityGroupConstraintsValidationV1TestSuite(regionalTestSuite suite
 		RegionalTestSuite: regionalTestSuite,
 	}
 	suite.ScenarioName = constants.SecurityGroupConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalNetworkResourceMetadataKindResourceKindSecurityGroup)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -171,9 +180,7 @@ func (suite *SecurityGroupConstraintsValidationV1TestSuite) BeforeAll(t provider
 }
 
 func (suite *SecurityGroupConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalNetworkResourceMetadataKindResourceKindSecurityGroup))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -213,21 +220,25 @@ func (suite *SecurityGroupConstraintsValidationV1TestSuite) TestScenario(t provi
 		"Create a security group with name exceeding maxLength:128 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthNameSecurityGroup,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidPatternNameSecurityGroup,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthLabelValueSecurityGroup,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthAnnotationSecurityGroup,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 
 	// Security Group inline rule violations
@@ -235,56 +246,67 @@ func (suite *SecurityGroupConstraintsValidationV1TestSuite) TestScenario(t provi
 		"Create a security group with rules[].direction outside enum [ingress, egress] — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidDirectionSecurityGroup,
+		steps.ValidationError().At("/spec/rules/0/direction"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with rules[].version outside enum [IPv4, IPv6] — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidVersionSecurityGroup,
+		steps.ValidationError().At("/spec/rules/0/version"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with rules[].protocol outside enum [tcp, udp, tcp+udp, icmp] — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidProtocolSecurityGroup,
+		steps.ValidationError().At("/spec/rules/0/protocol"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with rules[].ports.from exceeding maximum:65535 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverMaxPortFromSecurityGroup,
+		steps.ValidationError().At("/spec/rules/0/ports/from"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with rules[].ports.from below minimum:1 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.UnderMinPortFromSecurityGroup,
+		steps.ValidationError().At("/spec/rules/0/ports/from"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with rules[].ports.to exceeding maximum:65535 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverMaxPortToSecurityGroup,
+		steps.ValidationError().At("/spec/rules/0/ports/to"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with rules[].ports.to below minimum:1 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.UnderMinPortToSecurityGroup,
+		steps.ValidationError().At("/spec/rules/0/ports/to"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with rules[].ports.list[] exceeding maximum:65535 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverMaxPortListSecurityGroup,
+		steps.ValidationError().At("/spec/rules/0/ports/list/0"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with rules[].ports.list[] below minimum:1 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.UnderMinPortListSecurityGroup,
+		steps.ValidationError().At("/spec/rules/0/ports/list/0"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with rules[].icmp.type exceeding maximum:8 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverMaxIcmpTypeSecurityGroup,
+		steps.ValidationError().At("/spec/rules/0/icmp/type"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with rules[].icmp.code exceeding maximum:5 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverMaxIcmpCodeSecurityGroup,
+		steps.ValidationError().At("/spec/rules/0/icmp/code"),
 	)
 
 	stepsBuilder.DeleteWorkspaceV1Step("Delete the workspace", t, suite.Client.WorkspaceV1, workspace)
@@ -294,5 +316,6 @@ func (suite *SecurityGroupConstraintsValidationV1TestSuite) TestScenario(t provi
 }
 
 func (suite *SecurityGroupConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -15,7 +15,8 @@ import (
 )
 
 // SecurityGroupErrorV1TestSuite verifies that SecurityGroup resources with
-// invalid references are rejected by the API with 422 Unprocessable Entity.
+// invalid references are rejected by the API with 422 Unprocessable Entity at the
+// invalid field, or with 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create security group with invalid region
@@ -31,6 +32,15 @@ func CreateSecurityGroupErrorV1TestSuite(regionalTestSuite suites.RegionalTestSu
 		RegionalTestSuite: regionalTestSuite,
 	}
 	suite.ScenarioName = constants.SecurityGroupErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroup)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -110,9 +120,7 @@ func (suite *SecurityGroupErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *SecurityGroupErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroup))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -148,23 +156,26 @@ func (suite *SecurityGroupErrorV1TestSuite) TestScenario(t provider.T) {
 		},
 	)
 
-	// Error scenarios — all must be rejected with 422
+	// Error scenarios — rejected with 422, or 404 for a missing parent resource
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with invalid region — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidRegionSecurityGroup,
+		steps.ValidationError().At("/metadata/region"),
 	)
 
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with non-existent workspace — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentWorkspaceSecurityGroup,
+		steps.ResourceNotFoundError(),
 	)
 
 	stepsBuilder.CreateOrUpdateSecurityGroupExpectViolationV1Step(
 		"Create a security group with non-existent rule ref — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentRuleRefSecurityGroup,
+		steps.ValidationError().At("/spec/ruleRefs/0"),
 	)
 
 	// Teardown
@@ -175,5 +186,6 @@ func (suite *SecurityGroupErrorV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *SecurityGroupErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -44,6 +44,15 @@ func CreateSecurityGroupRuleConstraintsValidationV1TestSuite(regionalTestSuite s
 		RegionalTestSuite: regionalTestSuite,
 	}
 	suite.ScenarioName = constants.SecurityGroupRuleConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalNetworkResourceMetadataKindResourceKindSecurityGroupRule)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -163,9 +172,7 @@ func (suite *SecurityGroupRuleConstraintsValidationV1TestSuite) BeforeAll(t prov
 }
 
 func (suite *SecurityGroupRuleConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalNetworkResourceMetadataKindResourceKindSecurityGroupRule))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -205,21 +212,25 @@ func (suite *SecurityGroupRuleConstraintsValidationV1TestSuite) TestScenario(t p
 		"Create a security group rule with name exceeding maxLength:128 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthNameSecurityGroupRule,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidPatternNameSecurityGroupRule,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthLabelValueSecurityGroupRule,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthAnnotationSecurityGroupRule,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 
 	// Security Group Rule spec violations
@@ -227,56 +238,67 @@ func (suite *SecurityGroupRuleConstraintsValidationV1TestSuite) TestScenario(t p
 		"Create a security group rule with direction outside enum [ingress, egress] — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidDirectionSecurityGroupRule,
+		steps.ValidationError().At("/spec/direction"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with version outside enum [IPv4, IPv6] — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidVersionSecurityGroupRule,
+		steps.ValidationError().At("/spec/version"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with protocol outside enum [tcp, udp, tcp+udp, icmp] — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidProtocolSecurityGroupRule,
+		steps.ValidationError().At("/spec/protocol"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with ports.from exceeding maximum:65535 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverMaxPortFromSecurityGroupRule,
+		steps.ValidationError().At("/spec/ports/from"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with ports.from below minimum:1 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.UnderMinPortFromSecurityGroupRule,
+		steps.ValidationError().At("/spec/ports/from"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with ports.to exceeding maximum:65535 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverMaxPortToSecurityGroupRule,
+		steps.ValidationError().At("/spec/ports/to"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with ports.to below minimum:1 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.UnderMinPortToSecurityGroupRule,
+		steps.ValidationError().At("/spec/ports/to"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with ports.list[] exceeding maximum:65535 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverMaxPortListSecurityGroupRule,
+		steps.ValidationError().At("/spec/ports/list/0"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with ports.list[] below minimum:1 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.UnderMinPortListSecurityGroupRule,
+		steps.ValidationError().At("/spec/ports/list/0"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with icmp.type exceeding maximum:8 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverMaxIcmpTypeSecurityGroupRule,
+		steps.ValidationError().At("/spec/icmp/type"),
 	)
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with icmp.code exceeding maximum:5 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverMaxIcmpCodeSecurityGroupRule,
+		steps.ValidationError().At("/spec/icmp/code"),
 	)
 
 	stepsBuilder.DeleteWorkspaceV1Step("Delete the workspace", t, suite.Client.WorkspaceV1, workspace)
@@ -286,5 +308,6 @@ func (suite *SecurityGroupRuleConstraintsValidationV1TestSuite) TestScenario(t p
 }
 
 func (suite *SecurityGroupRuleConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -15,7 +15,8 @@ import (
 )
 
 // SecurityGroupRuleErrorV1TestSuite verifies that SecurityGroupRule resources with
-// invalid references are rejected by the API with 422 Unprocessable Entity.
+// invalid references are rejected by the API with 422 Unprocessable Entity at the
+// invalid field, or with 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create security group rule with invalid region
@@ -30,6 +31,15 @@ func CreateSecurityGroupRuleErrorV1TestSuite(regionalTestSuite suites.RegionalTe
 		RegionalTestSuite: regionalTestSuite,
 	}
 	suite.ScenarioName = constants.SecurityGroupRuleErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroupRule)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -92,9 +102,7 @@ func (suite *SecurityGroupRuleErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *SecurityGroupRuleErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroupRule))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -130,17 +138,19 @@ func (suite *SecurityGroupRuleErrorV1TestSuite) TestScenario(t provider.T) {
 		},
 	)
 
-	// Error scenarios — all must be rejected with 422
+	// Error scenarios — rejected with 422, or 404 for a missing parent resource
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with invalid region — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidRegionSecurityGroupRule,
+		steps.ValidationError().At("/metadata/region"),
 	)
 
 	stepsBuilder.CreateOrUpdateSecurityGroupRuleExpectViolationV1Step(
 		"Create a security group rule with non-existent workspace — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentWorkspaceSecurityGroupRule,
+		steps.ResourceNotFoundError(),
 	)
 
 	// Teardown
@@ -151,5 +161,6 @@ func (suite *SecurityGroupRuleErrorV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *SecurityGroupRuleErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -1,8 +1,6 @@
 package network
 
 import (
-	"log/slog"
-
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/params"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/steps"
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/suites"
@@ -27,6 +25,15 @@ func CreateSecurityGroupRuleLifeCycleV1TestSuite(regionalTestSuite suites.Region
 		RegionalTestSuite: regionalTestSuite,
 	}
 	suite.ScenarioName = constants.SecurityGroupRuleLifeCycleV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.LifeCycleKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalNetworkResourceMetadataKindResourceKindSecurityGroupRule)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -48,7 +55,7 @@ func (suite *SecurityGroupRuleLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 		}).
 		Build()
 	if err != nil {
-		slog.Error("Failed to build Workspace", "error", err)
+		suite.Logger().Error("Failed to build Workspace", "error", err)
 		t.FailNow()
 	}
 
@@ -65,7 +72,7 @@ func (suite *SecurityGroupRuleLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 		Spec(&schema.SecurityGroupRuleSpec{Direction: schema.SecurityGroupRuleDirectionIngress}).
 		Build()
 	if err != nil {
-		slog.Error("Failed to build Security Group Rule", "error", err)
+		suite.Logger().Error("Failed to build Security Group Rule", "error", err)
 		t.FailNow()
 	}
 
@@ -82,7 +89,7 @@ func (suite *SecurityGroupRuleLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 		Spec(&schema.SecurityGroupRuleSpec{Direction: schema.SecurityGroupRuleDirectionEgress}).
 		Build()
 	if err != nil {
-		slog.Error("Failed to build Security Group Rule", "error", err)
+		suite.Logger().Error("Failed to build Security Group Rule", "error", err)
 		t.FailNow()
 	}
 
@@ -94,15 +101,13 @@ func (suite *SecurityGroupRuleLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 	suite.params = params
 	err = suites.SetupMockIfEnabled(suite.TestSuite, mockNetwork.ConfigureSecurityGroupRuleLifecycleScenarioV1, *params)
 	if err != nil {
-		slog.Error("Failed to setup mock", "error", err)
+		suite.Logger().Error("Failed to setup mock", "error", err)
 		t.FailNow()
 	}
 }
 
 func (suite *SecurityGroupRuleLifeCycleV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalNetworkResourceMetadataKindResourceKindSecurityGroupRule))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -217,5 +222,6 @@ func (suite *SecurityGroupRuleLifeCycleV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *SecurityGroupRuleLifeCycleV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -40,6 +40,23 @@ func CreateSubnetConstraintsValidationV1TestSuite(regionalTestSuite suites.Regio
 		config:            config,
 	}
 	suite.ScenarioName = constants.SubnetConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:   []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		Skus:        []string{sdkconsts.NetworkProviderV1Name},
+		Zones:       true,
+		NetworkCidr: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSubnet)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindNetwork),
+			string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
+		},
+	}
 	return suite
 }
 
@@ -53,7 +70,7 @@ func (suite *SubnetConstraintsValidationV1TestSuite) BeforeAll(t provider.T) {
 	networkSkuName := suite.config.NetworkSkus[rand.Intn(len(suite.config.NetworkSkus))]
 	zone := suite.config.RegionZones[rand.Intn(len(suite.config.RegionZones))]
 
-	subnetCidr, err := generators.GenerateSubnetCidr(suite.config.NetworkCidr, 8, 1)
+	subnetCidr, err := generators.AllocateSubnetCidr(suite.config.NetworkCidr, 8)
 	if err != nil {
 		t.Fatalf("Failed to generate subnet cidr: %v", err)
 	}
@@ -192,14 +209,7 @@ func (suite *SubnetConstraintsValidationV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *SubnetConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSubnet))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindNetwork),
-		string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
-	)
+	suite.StartScenario(t)
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
 	// Workspace
@@ -353,26 +363,31 @@ func (suite *SubnetConstraintsValidationV1TestSuite) TestScenario(t provider.T)
 		"Create a subnet with name exceeding maxLength:128 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthNameSubnet,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateSubnetExpectViolationV1Step(
 		"Create a subnet with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidPatternNameSubnet,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateSubnetExpectViolationV1Step(
 		"Create a subnet with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthLabelValueSubnet,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdateSubnetExpectViolationV1Step(
 		"Create a subnet with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthAnnotationSubnet,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 	stepsBuilder.CreateOrUpdateSubnetExpectViolationV1Step(
 		"Create a subnet with zone exceeding maxLength:32 — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OverLengthZoneSubnet,
+		steps.ValidationError().At("/spec/zone"),
 	)
 
 	stepsBuilder.DeleteInternetGatewayV1Step("Delete the internet gateway", t, suite.Client.NetworkV1, internetGat)
@@ -391,5 +406,6 @@ func (suite *SubnetConstraintsValidationV1TestSuite) TestScenario(t provider.T)
 }
 
 func (suite *SubnetConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -17,7 +17,8 @@ import (
 )
 
 // SubnetErrorV1TestSuite verifies that Subnet resources with invalid references
-// are rejected by the API with 422 Unprocessable Entity.
+// are rejected by the API with 422 Unprocessable Entity at the invalid field, or with
+// 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create subnet with invalid region
@@ -39,6 +40,23 @@ func CreateSubnetErrorV1TestSuite(regionalTestSuite suites.RegionalTestSuite, co
 		config:            config,
 	}
 	suite.ScenarioName = constants.SubnetErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers:   []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.NetworkProviderV1Name},
+		Skus:        []string{sdkconsts.NetworkProviderV1Name},
+		Zones:       true,
+		NetworkCidr: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.NetworkProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSubnet)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindNetwork),
+			string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
+		},
+	}
 	return suite
 }
 
@@ -52,7 +70,7 @@ func (suite *SubnetErrorV1TestSuite) BeforeAll(t provider.T) {
 	networkSkuName := suite.config.NetworkSkus[rand.Intn(len(suite.config.NetworkSkus))]
 	zone := suite.config.RegionZones[rand.Intn(len(suite.config.RegionZones))]
 
-	subnetCidr, err := generators.GenerateSubnetCidr(suite.config.NetworkCidr, 8, 1)
+	subnetCidr, err := generators.AllocateSubnetCidr(suite.config.NetworkCidr, 8)
 	if err != nil {
 		t.Fatalf("Failed to generate subnet cidr: %v", err)
 	}
@@ -212,14 +230,7 @@ func (suite *SubnetErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *SubnetErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.NetworkProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSubnet))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindNetwork),
-		string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -362,41 +373,47 @@ func (suite *SubnetErrorV1TestSuite) TestScenario(t provider.T) {
 		},
 	)
 
-	// Error scenarios — all must be rejected with 422
+	// Error scenarios — rejected with 422, or 404 for a missing parent resource
 	stepsBuilder.CreateOrUpdateSubnetExpectViolationV1Step(
 		"Create a subnet with invalid region — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidRegionSubnet,
+		steps.ValidationError().At("/metadata/region"),
 	)
 
 	stepsBuilder.CreateOrUpdateSubnetExpectViolationV1Step(
 		"Create a subnet with invalid zone — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.InvalidZoneSubnet,
+		steps.ValidationError().At("/spec/zone"),
 	)
 
 	stepsBuilder.CreateOrUpdateSubnetExpectViolationV1Step(
 		"Create a subnet with non-existent workspace — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentWorkspaceSubnet,
+		steps.ResourceNotFoundError(),
 	)
 
 	stepsBuilder.CreateOrUpdateSubnetExpectViolationV1Step(
 		"Create a subnet with non-existent network — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentNetworkSubnet,
+		steps.ResourceNotFoundError(),
 	)
 
 	stepsBuilder.CreateOrUpdateSubnetExpectViolationV1Step(
 		"Create a subnet with non-existent routeTableRef — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.NonExistentRouteTableRefSubnet,
+		steps.ValidationError().At("/spec/routeTableRef"),
 	)
 
 	stepsBuilder.CreateOrUpdateSubnetExpectViolationV1Step(
 		"Create a subnet with CIDR outside network CIDR — expect rejection",
 		suite.Client.NetworkV1,
 		suite.params.OutsideCidrSubnet,
+		steps.ValidationError().At("/spec/cidr/ipv4"),
 	)
 
 	// Teardown — reverse dependency order
@@ -416,5 +433,6 @@ func (suite *SubnetErrorV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *SubnetErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -32,6 +32,14 @@ func CreateProviderQueriesV1TestSuite(globalTestSuite suites.GlobalTestSuite, cl
 		Regions:         append([]string{clientRegion}, additionalRegions...),
 	}
 	suite.ScenarioName = constants.RegionProviderQueriesV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.RegionProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.QueriesKind,
+		Providers: []string{sdkconsts.RegionProviderV1Name},
+		Resources: []string{string(schema.GlobalResourceMetadataKindResourceKindRegion)},
+	}
 	return suite
 }
 
@@ -65,8 +73,7 @@ func (suite *ProviderQueriesV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.RegionProviderV1Name)
-	suite.ConfigureResources(t, string(schema.GlobalResourceMetadataKindResourceKindRegion))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -93,5 +100,6 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *ProviderQueriesV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -0,0 +1,115 @@
+package suites
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/progress"
+	sdkconsts "github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+)
+
+// Suite Requirements
+
+// Requirements declares what a suite needs from the region and the scenario inputs to be able to run
+type Requirements struct {
+	Providers []string
+	Skus      []string
+	Zones     bool
+
+	Users             int
+	NetworkCidr       bool
+	PublicIpsRange    bool
+	AdditionalRegions int
+}
+
+// unmet returns a reason for every requirement not satisfied by the run configuration
+func (requirements Requirements) unmet(params *config.ParametersHolder, clients *config.ClientsHolder) []string {
+	var reasons []string
+
+	// The region can only be checked once the clients are initialized
+	if clients != nil && clients.GlobalClient != nil {
+		reasons = append(reasons, requirements.unmetByRegion(params, clients)...)
+	}
+
+	if len(params.ScenariosUsers) < requirements.Users {
+		reasons = append(reasons, fmt.Sprintf("scenarios.users needs at least %d entries", requirements.Users))
+	}
+	if requirements.NetworkCidr && params.ScenariosCidr == "" {
+		reasons = append(reasons, "scenarios.cidr is not set")
+	}
+	if requirements.PublicIpsRange && params.ScenariosPublicIps == "" {
+		reasons = append(reasons, "scenarios.public.ips is not set")
+	}
+	if len(params.ScenariosAdditionalRegions) < requirements.AdditionalRegions {
+		reasons = append(reasons, fmt.Sprintf("scenarios.additional.regions needs at least %d entries", requirements.AdditionalRegions))
+	}
+
+	return reasons
+}
+
+func (requirements Requirements) unmetByRegion(params *config.ParametersHolder, clients *config.ClientsHolder) []string {
+	var reasons []string
+
+	for _, name := range requirements.Providers {
+		if !slices.Contains(clients.Providers, name) {
+			reasons = append(reasons, fmt.Sprintf("provider %s is not available in the region", name))
+		}
+	}
+
+	skus := map[string][]string{
+		sdkconsts.ComputeProviderV1Name: clients.InstanceSkus,
+		sdkconsts.StorageProviderV1Name: clients.StorageSkus,
+		sdkconsts.NetworkProviderV1Name: clients.NetworkSkus,
+	}
+	for _, name := range requirements.Skus {
+		// An unavailable provider is already reported
+		if slices.Contains(clients.Providers, name) && len(skus[name]) == 0 {
+			reasons = append(reasons, fmt.Sprintf("provider %s offers no skus", name))
+		}
+	}
+
+	if requirements.Zones && len(clients.RegionZones) == 0 {
+		reasons = append(reasons, fmt.Sprintf("region %s reports no available zones", params.ClientRegion))
+	}
+
+	return reasons
+}
+
+// inputs names the scenario parameters the suite reads
+func (requirements Requirements) inputs() []string {
+	var inputs []string
+	if requirements.Users > 0 {
+		inputs = append(inputs, "scenarios.users")
+	}
+	if requirements.NetworkCidr {
+		inputs = append(inputs, "scenarios.cidr")
+	}
+	if requirements.PublicIpsRange {
+		inputs = append(inputs, "scenarios.public.ips")
+	}
+	if requirements.AdditionalRegions > 0 {
+		inputs = append(inputs, "scenarios.additional.regions")
+	}
+	return inputs
+}
+
+// UnmetRequirements returns the reasons the suite can not run, evaluated by CanRun
+func (suite *TestSuite) UnmetRequirements() []string {
+	return suite.unmetRequirements
+}
+
+// SkipScenario records the scenario as skipped in the report, with its unmet requirements as the reason
+func (suite *TestSuite) SkipScenario(t provider.T) {
+	reason := "Unmet requirements: " + strings.Join(suite.unmetRequirements, "; ")
+	suite.Logger().Warn("Skipping scenario", "reason", reason)
+	progress.ScenarioFinished(suite.ScenarioName, progress.Skipped)
+
+	t.Title(suite.ScenarioName)
+	suite.addReportLabels(t)
+	suite.addReportTags(t)
+	t.Skip(reason)
+}
@@ -42,6 +42,16 @@ func CreateBlockStorageConstraintsValidationV1TestSuite(regionalTestSuite suites
 		StorageSkus:       storageSkus,
 	}
 	suite.ScenarioName = constants.BlockStorageConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.StorageProviderV1Name},
+		Skus:      []string{sdkconsts.StorageProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.StorageProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -139,9 +149,7 @@ func (suite *BlockStorageConstraintsValidationV1TestSuite) BeforeAll(t provider.
 }
 
 func (suite *BlockStorageConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.StorageProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -184,6 +192,7 @@ func (suite *BlockStorageConstraintsValidationV1TestSuite) TestScenario(t provid
 		"Create a block storage with name exceeding maxLength:128 — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.OverLengthNameBlockStorage,
+		steps.ValidationError().At("/metadata/name"),
 	)
 
 	// name: pattern — must be rejected
@@ -191,6 +200,7 @@ func (suite *BlockStorageConstraintsValidationV1TestSuite) TestScenario(t provid
 		"Create a block storage with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.InvalidPatternNameBlockStorage,
+		steps.ValidationError().At("/metadata/name"),
 	)
 
 	// labels value: maxLength 63 — must be rejected
@@ -198,6 +208,7 @@ func (suite *BlockStorageConstraintsValidationV1TestSuite) TestScenario(t provid
 		"Create a block storage with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.OverLengthLabelValueBlockStorage,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 
 	// annotations value: maxLength 1024 — must be rejected
@@ -205,6 +216,7 @@ func (suite *BlockStorageConstraintsValidationV1TestSuite) TestScenario(t provid
 		"Create a block storage with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.OverLengthAnnotationBlockStorage,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 
 	// spec.sizeGB: maximum 1000000 — must be rejected
@@ -212,6 +224,7 @@ func (suite *BlockStorageConstraintsValidationV1TestSuite) TestScenario(t provid
 		"Create a block storage with sizeGB exceeding maximum:1000000 — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.OverMaxSizeBlockStorage,
+		steps.ValidationError().At("/spec/sizeGB"),
 	)
 
 	// spec.sizeGB: must be >= 1 — must be rejected
@@ -219,6 +232,7 @@ func (suite *BlockStorageConstraintsValidationV1TestSuite) TestScenario(t provid
 		"Create a block storage with sizeGB below minimum:1 — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.ZeroSizeBlockStorage,
+		steps.ValidationError().At("/spec/sizeGB"),
 	)
 
 	// Teardown workspace
@@ -229,5 +243,6 @@ func (suite *BlockStorageConstraintsValidationV1TestSuite) TestScenario(t provid
 }
 
 func (suite *BlockStorageConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
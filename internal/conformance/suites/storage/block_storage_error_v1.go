@@ -18,7 +18,8 @@ import (
 )
 
 // BlockStorageErrorV1TestSuite verifies that BlockStorage resources with
-// invalid references are rejected by the API with 422 Unprocessable Entity.
+// invalid references are rejected by the API with 422 Unprocessable Entity at the
+// invalid field, or with 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create block storage with invalid region
@@ -38,6 +39,16 @@ func CreateBlockStorageErrorV1TestSuite(regionalTestSuite suites.RegionalTestSui
 		StorageSkus:       storageSkus,
 	}
 	suite.ScenarioName = constants.BlockStorageErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.StorageProviderV1Name},
+		Skus:      []string{sdkconsts.StorageProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.StorageProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage)},
+		Depends:   []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -125,9 +136,7 @@ func (suite *BlockStorageErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *BlockStorageErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.StorageProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage))
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -170,6 +179,7 @@ func (suite *BlockStorageErrorV1TestSuite) TestScenario(t provider.T) {
 		"Create a block storage with invalid region — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.InvalidRegionBlockStorage,
+		steps.ValidationError().At("/metadata/region"),
 	)
 
 	// invalid SKU — must be rejected
@@ -177,6 +187,7 @@ func (suite *BlockStorageErrorV1TestSuite) TestScenario(t provider.T) {
 		"Create a block storage with invalid SKU — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.InvalidSkuBlockStorage,
+		steps.ValidationError().At("/spec/skuRef"),
 	)
 
 	// non-existent workspace — must be rejected
@@ -184,6 +195,7 @@ func (suite *BlockStorageErrorV1TestSuite) TestScenario(t provider.T) {
 		"Create a block storage with non-existent workspace — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.NonExistentWorkspaceBlockStorage,
+		steps.ResourceNotFoundError(),
 	)
 
 	// Teardown workspace
@@ -194,5 +206,6 @@ func (suite *BlockStorageErrorV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *BlockStorageErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
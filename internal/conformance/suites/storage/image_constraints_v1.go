@@ -42,6 +42,19 @@ func CreateImageConstraintsValidationV1TestSuite(regionalTestSuite suites.Region
 		StorageSkus:       storageSkus,
 	}
 	suite.ScenarioName = constants.ImageConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.StorageProviderV1Name},
+		Skus:      []string{sdkconsts.StorageProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.StorageProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindImage)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage),
+		},
+	}
 	return suite
 }
 
@@ -179,12 +192,7 @@ func (suite *ImageConstraintsValidationV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *ImageConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.StorageProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindImage))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -258,41 +266,49 @@ func (suite *ImageConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
 		"Create an image with name exceeding maxLength:128 — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.OverLengthNameImage,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateImageExpectViolationV1Step(
 		"Create an image with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.InvalidPatternNameImage,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateImageExpectViolationV1Step(
 		"Create an image with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.OverLengthLabelValueImage,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdateImageExpectViolationV1Step(
 		"Create an image with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.OverLengthAnnotationImage,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 	stepsBuilder.CreateOrUpdateImageExpectViolationV1Step(
 		"Create an image with invalid cpuArchitecture enum value — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.InvalidCpuArchitectureImage,
+		steps.ValidationError().At("/spec/cpuArchitecture"),
 	)
 	stepsBuilder.CreateOrUpdateImageExpectViolationV1Step(
 		"Create an image with invalid initializer enum value — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.InvalidInitializerImage,
+		steps.ValidationError().At("/spec/initializer"),
 	)
 	stepsBuilder.CreateOrUpdateImageExpectViolationV1Step(
 		"Create an image with invalid boot enum value — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.InvalidBootImage,
+		steps.ValidationError().At("/spec/boot"),
 	)
 
 	suite.FinishScenario()
 }
 
 func (suite *ImageConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
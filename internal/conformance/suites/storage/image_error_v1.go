@@ -39,6 +39,19 @@ func CreateImageErrorV1TestSuite(regionalTestSuite suites.RegionalTestSuite, sto
 		StorageSkus:       storageSkus,
 	}
 	suite.ScenarioName = constants.ImageErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.StorageProviderV1Name},
+		Skus:      []string{sdkconsts.StorageProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.StorageProviderV1Name},
+		Resources: []string{string(schema.RegionalWorkspaceResourceMetadataKindResourceKindImage)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage),
+		},
+	}
 	return suite
 }
 
@@ -164,12 +177,7 @@ func (suite *ImageErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *ImageErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.StorageProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalWorkspaceResourceMetadataKindResourceKindImage))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -248,24 +256,28 @@ func (suite *ImageErrorV1TestSuite) TestScenario(t provider.T) {
 		"Create an image with invalid region — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.InvalidRegionImage,
+		steps.ValidationError().At("/metadata/region"),
 	)
 
 	stepsBuilder.CreateOrUpdateImageExpectViolationV1Step(
 		"Create an image with invalid cpuArchitecture (x86_64 not in [amd64, arm64]) — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.InvalidCpuArchitectureImage,
+		steps.ValidationError().At("/spec/cpuArchitecture"),
 	)
 
 	stepsBuilder.CreateOrUpdateImageExpectViolationV1Step(
 		"Create an image with block storage in a different region — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.CrossRegionBlockStorageImage,
+		steps.ValidationError().At("/spec/blockStorageRef"),
 	)
 
 	stepsBuilder.CreateOrUpdateImageExpectViolationV1Step(
 		"Create an image with non-existent workspace in blockStorageRef — expect rejection",
 		suite.Client.StorageV1,
 		suite.params.NonExistentWorkspaceImage,
+		steps.ValidationError().At("/spec/blockStorageRef"),
 	)
 
 	// Teardown
@@ -280,5 +292,6 @@ func (suite *ImageErrorV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *ImageErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -30,6 +30,19 @@ func CreateProviderLifeCycleV1TestSuite(regionalTestSuite suites.RegionalTestSui
 		StorageSkus:       storageSkus,
 	}
 	suite.ScenarioName = constants.StorageProviderLifeCycleV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.StorageProviderV1Name},
+		Skus:      []string{sdkconsts.StorageProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.LifeCycleKind,
+		Providers: []string{sdkconsts.StorageProviderV1Name},
+		Resources: []string{
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindImage),
+		},
+		Depends: []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -154,12 +167,7 @@ func (suite *ProviderLifeCycleV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *ProviderLifeCycleV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.StorageProviderV1Name)
-	suite.ConfigureResources(t,
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindImage),
-	)
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -344,5 +352,6 @@ func (suite *ProviderLifeCycleV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *ProviderLifeCycleV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
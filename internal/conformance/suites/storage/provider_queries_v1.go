@@ -13,7 +13,6 @@ import (
 	sdkconsts "github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
 	"github.com/eu-sovereign-cloud/go-sdk/secapi"
-	labelBuilder "github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
 
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 )
@@ -32,6 +31,20 @@ func CreateProviderQueriesV1TestSuite(regionalTestSuite suites.RegionalTestSuite
 		StorageSkus:       storageSkus,
 	}
 	suite.ScenarioName = constants.StorageProviderQueriesV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name, sdkconsts.StorageProviderV1Name},
+		Skus:      []string{sdkconsts.StorageProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.QueriesKind,
+		Providers: []string{sdkconsts.StorageProviderV1Name},
+		Resources: []string{
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindImage),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindStorageSku),
+		},
+		Depends: []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -203,13 +216,7 @@ func (suite *ProviderQueriesV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.StorageProviderV1Name)
-	suite.ConfigureResources(t,
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindBlockStorage),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindImage),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindStorageSku),
-	)
-	suite.ConfigureDepends(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -252,14 +259,12 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List block storages with label
 	stepsBuilder.ListBlockStorageV1Step("List block storages with label", suite.Client.StorageV1, wpath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()),
 		blockStorageExpects)
 
 	// List block storages with limit and label
 	stepsBuilder.ListBlockStorageV1Step("List block storages with limit and label", suite.Client.StorageV1, wpath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		blockStorageExpects)
 
 	// Image
@@ -286,14 +291,12 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 
 	// List images with label
 	stepsBuilder.ListImageV1Step("List images with label", suite.Client.StorageV1, tpath,
-		secapi.NewListOptions().WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLabels(suite.FixtureLabelsSelector()),
 		imageExpects)
 
 	// List images with limit and label
 	stepsBuilder.ListImageV1Step("List images", suite.Client.StorageV1, tpath,
-		secapi.NewListOptions().WithLimit(1).WithLabels(labelBuilder.NewLabelsBuilder().
-			Equals(constants.EnvLabel, constants.EnvConformanceLabel)),
+		secapi.NewListOptions().WithLimit(1).WithLabels(suite.FixtureLabelsSelector()),
 		imageExpects)
 
 	// Skus
@@ -334,5 +337,6 @@ func (suite *ProviderQueriesV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *ProviderQueriesV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
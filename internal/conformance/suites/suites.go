@@ -1,14 +1,23 @@
 package suites
 
 import (
+	"context"
 	"log/slog"
-	"regexp"
+	"sync"
+	"time"
 
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/config"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/har"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/progress"
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/selector"
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+	"github.com/eu-sovereign-cloud/conformance/internal/logging"
 	"github.com/eu-sovereign-cloud/conformance/internal/mock"
 	mockscenarios "github.com/eu-sovereign-cloud/conformance/internal/mock/scenarios"
 	"github.com/eu-sovereign-cloud/go-sdk/secapi"
+	"github.com/eu-sovereign-cloud/go-sdk/secapi/builders"
 
+	"github.com/ozontech/allure-go/pkg/allure"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/suite"
 )
@@ -22,16 +31,34 @@ type TestSuite struct {
 	MockEnabled   bool
 	MockServerURL *string
 
-	MockClient   *mock.MockClient
 	MockScenario *mockscenarios.Scenario
 	ScenarioName string
+	RunID        string
+	Profile      string
+	Level        constants.ConformanceLevel
+
+	Tags              Tags
+	Requirements      Requirements
+	unmetRequirements []string
+	params            *config.ParametersHolder
+	clients           *config.ClientsHolder
+
+	Ledger *ResourceLedger
+
+	// exchanges records the HTTP exchanges of the scenario, when reported or validated
+	exchanges *har.Recorder
+	// verifiedExchanges counts the exchanges whose responses were validated already
+	verifiedExchanges int
+	// mutations are the calls that last changed each resource of the scenario, by its URL path
+	mutations     map[string]mutation
+	mutationsLock sync.Mutex
 
 	BaseDelay    int
 	BaseInterval int
 	MaxAttempts  int
 }
 
-func createTestSuite(params *config.ParametersHolder) *TestSuite {
+func createTestSuite(params *config.ParametersHolder, clients *config.ClientsHolder) *TestSuite {
 	return &TestSuite{
 		Tenant:        params.ClientTenant,
 		AuthToken:     params.ClientAuthToken,
@@ -40,46 +67,170 @@ func createTestSuite(params *config.ParametersHolder) *TestSuite {
 		BaseDelay:     params.BaseDelay,
 		BaseInterval:  params.BaseInterval,
 		MaxAttempts:   params.MaxAttempts,
+		RunID:         params.RunID,
+		Profile:       params.Profile,
+		Level:         params.ConformanceLevel,
+		params:        params,
+		clients:       clients,
+		Ledger:        NewResourceLedger(),
+	}
+}
+
+// CanRun reports whether the scenario is selected, evaluating its requirements to skip it when unmet
+func (suite *TestSuite) CanRun(selector *selector.Selector) bool {
+	if !suite.selected(selector) {
+		return false
 	}
+
+	suite.unmetRequirements = suite.Requirements.unmet(suite.params, suite.clients)
+	return true
 }
 
-func (suite *TestSuite) CanRun(regexp *regexp.Regexp) bool {
-	if regexp == nil {
-		return true
-	} else {
-		return regexp.MatchString(suite.ScenarioName)
+// selected reports whether the selector matches the scenario and it is mandatory at the run level, if any
+func (suite *TestSuite) selected(selector *selector.Selector) bool {
+	if suite.Level != "" && !suite.Level.Includes(constants.SuiteLevel(suite.ScenarioName)) {
+		return false
 	}
+	return selector.Matches(suite.ScenarioName, suite.Tags.values())
 }
 
-func (suite *TestSuite) StartScenario(t provider.T, provider ...string) {
-	slog.Info("Starting execution of scenario " + suite.ScenarioName)
+func (suite *TestSuite) StartScenario(t provider.T) {
+	suite.Logger().Info("Starting execution of scenario")
+	suite.reportProgress(t)
+	if suite.params.ReportHar || suite.clients.Responses != nil {
+		suite.exchanges = har.NewRecorder()
+	}
 	t.Title(suite.ScenarioName)
-	for _, provider := range provider {
-		t.Tags("provider:" + provider)
+	suite.addReportLabels(t)
+	suite.addReportTags(t)
+}
+
+// Context is the context of the calls of the scenario, recording their exchanges
+func (suite *TestSuite) Context(t provider.T) context.Context {
+	return har.WithRecorder(t.Context(), suite.exchanges)
+}
+
+// AfterEach attaches the recorded exchanges to the report, also when a step stopped the scenario
+func (suite *TestSuite) AfterEach(t provider.T) {
+	if !suite.params.ReportHar || suite.exchanges == nil || suite.exchanges.Len() == 0 {
+		return
 	}
+
+	data, err := suite.exchanges.Encode()
+	if err != nil {
+		suite.Logger().Error("Failed to attach the HTTP exchanges", "error", err)
+		return
+	}
+	t.WithNewAttachment(suite.ScenarioName+".har", allure.JSON, data)
 }
 
-func (suite *TestSuite) FinishScenario() {
-	slog.Info("Finishing execution of scenario " + suite.ScenarioName)
+// Logger carries the scenario name on its records
+func (suite *TestSuite) Logger() *slog.Logger {
+	return slog.With(logging.ScenarioKey, suite.ScenarioName)
 }
 
-func (suite *TestSuite) ConfigureResources(t provider.T, kinds ...string) {
-	for _, kind := range kinds {
-		t.Tags("resource:" + kind)
+// StepLogger carries the scenario and step names on its records, with the provider and operation set as step parameters
+func (suite *TestSuite) StepLogger(sCtx provider.StepCtx, stepName string) *slog.Logger {
+	attrs := []any{logging.StepKey, stepName}
+	for _, parameter := range sCtx.CurrentStep().Parameters {
+		switch parameter.Name {
+		case providerStepParameter:
+			attrs = append(attrs, logging.ProviderKey, parameter.Value)
+		case operationStepParameter:
+			attrs = append(attrs, logging.OperationKey, parameter.Value)
+		}
 	}
+	return suite.Logger().With(attrs...)
 }
 
-func (suite *TestSuite) ConfigureDepends(t provider.T, kinds ...string) {
-	for _, kind := range kinds {
-		t.Tags("depends:" + kind)
+// reportProgress counts the outcome of the scenario once its test ends, even when a step stops it
+func (suite *TestSuite) reportProgress(t provider.T) {
+	progress.ScenarioStarted(suite.ScenarioName)
+	t.Cleanup(func() {
+		switch {
+		case t.Skipped():
+			progress.ScenarioFinished(suite.ScenarioName, progress.Skipped)
+		case t.Failed():
+			progress.ScenarioFinished(suite.ScenarioName, progress.Failed)
+		default:
+			progress.ScenarioFinished(suite.ScenarioName, progress.Passed)
+		}
+	})
+}
+
+func (suite *TestSuite) addReportLabels(t provider.T) {
+	if suite.RunID != "" {
+		t.Label(allure.NewLabel(allure.LabelType(constants.RunIDReportLabel), suite.RunID))
+	}
+	if suite.Profile != "" {
+		t.Label(allure.NewLabel(allure.LabelType(constants.ProfileReportLabel), suite.Profile))
 	}
+	if suite.Level != "" {
+		t.Label(allure.NewLabel(allure.LabelType(constants.LevelReportLabel), suite.Level.String()))
+	}
+	// The summary evaluates the percentile thresholds over the measures of every scenario
+	for _, threshold := range suite.params.Thresholds {
+		t.Label(allure.NewLabel(allure.LabelType(constants.SloReportLabel), threshold.String()))
+	}
+}
+
+// FixtureLabelsSelector matches the conformance fixtures created by the current run only
+func (suite *TestSuite) FixtureLabelsSelector() *builders.LabelsBuilder {
+	selector := builders.NewLabelsBuilder().Equals(constants.EnvLabel, constants.EnvConformanceLabel)
+	if suite.RunID != "" {
+		selector.Equals(constants.RunIDLabel, suite.RunID)
+	}
+	return selector
+}
+
+func (suite *TestSuite) FinishScenario() {
+	suite.Logger().Info("Finishing execution of scenario")
+}
+
+func (suite *TestSuite) CleanupResources(t provider.T) {
+	pending := suite.Ledger.Pending()
+	if len(pending) == 0 {
+		return
+	}
+
+	// Mock scenarios keep no state after being reset, so there is nothing leaked to delete
+	if suite.MockEnabled {
+		suite.Ledger.Reset()
+		return
+	}
+
+	suite.Logger().Warn("Cleaning up leftover resources", "count", len(pending))
+
+	config := secapi.ResourceObserverConfig{
+		Delay:       time.Duration(suite.BaseDelay) * time.Second,
+		Interval:    time.Duration(suite.BaseInterval) * time.Second,
+		MaxAttempts: suite.MaxAttempts,
+	}
+	t.WithNewStep("Cleanup", func(sCtx provider.StepCtx) {
+		for _, entry := range pending {
+			sCtx.WithNewStep("Delete the "+entry.Kind, func(stepCtx provider.StepCtx) {
+				stepCtx.WithNewParameters(referenceStepParameter, entry.Reference)
+				suite.StepLogger(stepCtx, "Delete the "+entry.Kind).Info("Running step", "reference", entry.Reference)
+
+				err := entry.DeleteFunc(t.Context())
+				if err == nil {
+					err = entry.WatchFunc(t.Context(), config)
+				}
+				// Assert instead of require, so one stuck resource does not leak the remaining ones
+				stepCtx.Assert().NoError(err, "Should delete the leftover resource")
+				if err == nil {
+					suite.Ledger.Release(entry.Reference)
+				}
+			})
+		}
+	})
 }
 
-func (suite *TestSuite) ResetAllScenarios() {
-	// Cleanup configured mock scenarios
-	if suite.MockClient != nil {
-		if err := suite.MockClient.ResetAllScenarios(); err != nil {
-			slog.Error("Failed to reset scenarios", "error", err)
+func (suite *TestSuite) ResetScenario() {
+	// Cleanup the configured mock scenario
+	if suite.MockScenario != nil {
+		if err := suite.MockScenario.ResetScenario(); err != nil {
+			suite.Logger().Error("Failed to reset scenario", "error", err)
 		}
 	}
 }
@@ -111,7 +262,7 @@ type GlobalTestSuite struct {
 
 func CreateGlobalTestSuite(params *config.ParametersHolder, clients *config.ClientsHolder) GlobalTestSuite {
 	return GlobalTestSuite{
-		TestSuite: createTestSuite(params),
+		TestSuite: createTestSuite(params, clients),
 		Client:    clients.GlobalClient,
 	}
 }
@@ -126,7 +277,7 @@ type RegionalTestSuite struct {
 
 func CreateRegionalTestSuite(params *config.ParametersHolder, clients *config.ClientsHolder) RegionalTestSuite {
 	return RegionalTestSuite{
-		TestSuite: createTestSuite(params),
+		TestSuite: createTestSuite(params, clients),
 		Region:    params.ClientRegion,
 		Client:    clients.RegionalClient,
 	}
@@ -145,7 +296,7 @@ type MixedTestSuite struct {
 
 func CreateMixedTestSuite(params *config.ParametersHolder, clients *config.ClientsHolder) MixedTestSuite {
 	return MixedTestSuite{
-		TestSuite:      createTestSuite(params),
+		TestSuite:      createTestSuite(params, clients),
 		Region:         params.ClientRegion,
 		GlobalClient:   clients.GlobalClient,
 		RegionalClient: clients.RegionalClient,
@@ -0,0 +1,48 @@
+package suites
+
+import (
+	"github.com/eu-sovereign-cloud/conformance/internal/conformance/selector"
+
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+)
+
+// Suite Tags
+
+// Tags declares the metadata of a suite, known before it runs so the suite can be selected by it
+type Tags struct {
+	Kind      string
+	Providers []string
+	Resources []string
+	Depends   []string
+}
+
+// Suite Kinds
+const (
+	LifeCycleKind   = "LifeCycle"
+	QueriesKind     = "Queries"
+	ConstraintsKind = "Constraints"
+	ErrorKind       = "Error"
+	UsageKind       = "Usage"
+)
+
+func (tags Tags) values() map[string][]string {
+	return map[string][]string{
+		selector.KindKey:     {tags.Kind},
+		selector.ProviderKey: tags.Providers,
+		selector.ResourceKey: tags.Resources,
+		selector.DependsKey:  tags.Depends,
+	}
+}
+
+func (suite *TestSuite) addReportTags(t provider.T) {
+	t.Tags(selector.KindKey + ":" + suite.Tags.Kind)
+	for _, provider := range suite.Tags.Providers {
+		t.Tags(selector.ProviderKey + ":" + provider)
+	}
+	for _, kind := range suite.Tags.Resources {
+		t.Tags(selector.ResourceKey + ":" + kind)
+	}
+	for _, kind := range suite.Tags.Depends {
+		t.Tags(selector.DependsKey + ":" + kind)
+	}
+}
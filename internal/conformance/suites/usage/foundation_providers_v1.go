@@ -1,7 +1,6 @@
 package usage
 
 import (
-	"log/slog"
 	"math/rand"
 	"net/http"
 
@@ -42,29 +41,73 @@ func CreateFoundationProvidersV1TestSuite(mixedTestSuite suites.MixedTestSuite,
 		config:         config,
 	}
 	suite.ScenarioName = constants.UsageFoundationProvidersV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{
+			sdkconsts.AuthorizationProviderV1Name,
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.NetworkProviderV1Name,
+		},
+		Skus: []string{
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.NetworkProviderV1Name,
+		},
+		Zones:          true,
+		Users:          1,
+		NetworkCidr:    true,
+		PublicIpsRange: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind: suites.UsageKind,
+		Providers: []string{
+			sdkconsts.AuthorizationProviderV1Name,
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.NetworkProviderV1Name,
+		},
+		Resources: []string{
+			string(schema.GlobalTenantResourceMetadataKindResourceKindRole),
+			string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment),
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+			string(schema.RegionalResourceMetadataKindResourceKindImage),
+			string(schema.RegionalResourceMetadataKindResourceKindInstance),
+			string(schema.RegionalResourceMetadataKindResourceKindNetwork),
+			string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+			string(schema.RegionalResourceMetadataKindResourceKindNic),
+			string(schema.RegionalResourceMetadataKindResourceKindPublicIP),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindSubnet),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroupRule),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroup),
+		},
+	}
 	return suite
 }
 
 func (suite *FoundationProvidersV1TestSuite) BeforeAll(t provider.T) {
 	t.AddParentSuite(suites.UsageParentSuite)
 
-	subnetCidr, err := generators.GenerateSubnetCidr(suite.config.NetworkCidr, 8, 1)
+	subnetCidr, err := generators.AllocateSubnetCidr(suite.config.NetworkCidr, 8)
 	if err != nil {
-		slog.Error("Failed to generate subnet cidr", "error", err)
+		suite.Logger().Error("Failed to generate subnet cidr", "error", err)
 		t.FailNow()
 	}
 
 	// Generate the nic addresses
 	nicAddress1, err := generators.GenerateNicAddress(subnetCidr, 1)
 	if err != nil {
-		slog.Error("Failed to generate nic address", "error", err)
+		suite.Logger().Error("Failed to generate nic address", "error", err)
 		t.FailNow()
 	}
 
 	// Generate the public ips
-	publicIpAddress1, err := generators.GeneratePublicIp(suite.config.PublicIpsRange, 1)
+	publicIpAddress1, err := generators.AllocatePublicIp(suite.config.PublicIpsRange)
 	if err != nil {
-		slog.Error("Failed to generate public ip", "error", err)
+		suite.Logger().Error("Failed to generate public ip", "error", err)
 		t.FailNow()
 	}
 
@@ -307,29 +350,7 @@ func (suite *FoundationProvidersV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *FoundationProvidersV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t,
-		sdkconsts.AuthorizationProviderV1Name,
-		sdkconsts.WorkspaceProviderV1Name,
-		sdkconsts.StorageProviderV1Name,
-		sdkconsts.ComputeProviderV1Name,
-		sdkconsts.NetworkProviderV1Name,
-	)
-	suite.ConfigureResources(t,
-		string(schema.GlobalTenantResourceMetadataKindResourceKindRole),
-		string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment),
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
-		string(schema.RegionalResourceMetadataKindResourceKindImage),
-		string(schema.RegionalResourceMetadataKindResourceKindInstance),
-		string(schema.RegionalResourceMetadataKindResourceKindNetwork),
-		string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
-		string(schema.RegionalResourceMetadataKindResourceKindNic),
-		string(schema.RegionalResourceMetadataKindResourceKindPublicIP),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindSubnet),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroupRule),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroup),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -739,5 +760,6 @@ func (suite *FoundationProvidersV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *FoundationProvidersV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -42,6 +42,28 @@ func CreateHaMultiZoneV1TestSuite(regionalTestSuite suites.RegionalTestSuite, co
 		config:            config,
 	}
 	suite.ScenarioName = constants.UsageHaMultiZoneV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+		},
+		Skus:  []string{sdkconsts.ComputeProviderV1Name, sdkconsts.StorageProviderV1Name},
+		Zones: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind: suites.UsageKind,
+		Providers: []string{
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+		},
+		Resources: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+			string(schema.RegionalResourceMetadataKindResourceKindInstance),
+		},
+	}
 	return suite
 }
 
@@ -160,16 +182,7 @@ func (suite *HaMultiZoneV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *HaMultiZoneV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t,
-		sdkconsts.WorkspaceProviderV1Name,
-		sdkconsts.StorageProviderV1Name,
-		sdkconsts.ComputeProviderV1Name,
-	)
-	suite.ConfigureResources(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
-		string(schema.RegionalResourceMetadataKindResourceKindInstance),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -331,7 +344,8 @@ func (suite *HaMultiZoneV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *HaMultiZoneV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
 
 func indexOf(items []string, target string) int {
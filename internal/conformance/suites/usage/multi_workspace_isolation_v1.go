@@ -1,7 +1,6 @@
 package usage
 
 import (
-	"log/slog"
 	"math/rand"
 	"net/http"
 
@@ -48,31 +47,73 @@ func CreateMultiWorkspaceIsolationV1TestSuite(mixedTestSuite suites.MixedTestSui
 		config:         config,
 	}
 	suite.ScenarioName = constants.UsageMultiWorkspaceIsolationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{
+			sdkconsts.AuthorizationProviderV1Name,
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.NetworkProviderV1Name,
+		},
+		Skus: []string{
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.NetworkProviderV1Name,
+		},
+		Zones:          true,
+		Users:          1,
+		NetworkCidr:    true,
+		PublicIpsRange: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind: suites.UsageKind,
+		Providers: []string{
+			sdkconsts.AuthorizationProviderV1Name,
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.NetworkProviderV1Name,
+		},
+		Resources: []string{
+			string(schema.GlobalTenantResourceMetadataKindResourceKindRole),
+			string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment),
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+			string(schema.RegionalResourceMetadataKindResourceKindInstance),
+			string(schema.RegionalResourceMetadataKindResourceKindNetwork),
+			string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+			string(schema.RegionalResourceMetadataKindResourceKindNic),
+			string(schema.RegionalResourceMetadataKindResourceKindPublicIP),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindSubnet),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroup),
+		},
+	}
 	return suite
 }
 
 // buildWorkspaceStack builds one fully independent regional resource stack (network
-// topology + storage + a single instance) for the given workspace, using netNum to
-// derive a non-overlapping subnet and public IP from the shared scenario ranges.
-func (suite *MultiWorkspaceIsolationV1TestSuite) buildWorkspaceStack(t provider.T, workspaceName string, netNum int) params.WorkspaceStackV1 {
+// topology + storage + a single instance) for the given workspace, allocating a
+// non-overlapping subnet and public IP from the shared scenario ranges.
+func (suite *MultiWorkspaceIsolationV1TestSuite) buildWorkspaceStack(t provider.T, workspaceName string) params.WorkspaceStackV1 {
 	zone := suite.config.RegionZones[rand.Intn(len(suite.config.RegionZones))]
 	storageSkuName := suite.config.StorageSkus[rand.Intn(len(suite.config.StorageSkus))]
 	instanceSkuName := suite.config.InstanceSkus[rand.Intn(len(suite.config.InstanceSkus))]
 	networkSkuName := suite.config.NetworkSkus[rand.Intn(len(suite.config.NetworkSkus))]
 
-	subnetCidr, err := generators.GenerateSubnetCidr(suite.config.NetworkCidr, 8, netNum)
+	subnetCidr, err := generators.AllocateSubnetCidr(suite.config.NetworkCidr, 8)
 	if err != nil {
-		slog.Error("Failed to generate subnet cidr", "error", err)
+		suite.Logger().Error("Failed to generate subnet cidr", "error", err)
 		t.FailNow()
 	}
 	nicAddress, err := generators.GenerateNicAddress(subnetCidr, 1)
 	if err != nil {
-		slog.Error("Failed to generate nic address", "error", err)
+		suite.Logger().Error("Failed to generate nic address", "error", err)
 		t.FailNow()
 	}
-	publicIpAddress, err := generators.GeneratePublicIp(suite.config.PublicIpsRange, netNum)
+	publicIpAddress, err := generators.AllocatePublicIp(suite.config.PublicIpsRange)
 	if err != nil {
-		slog.Error("Failed to generate public ip", "error", err)
+		suite.Logger().Error("Failed to generate public ip", "error", err)
 		t.FailNow()
 	}
 
@@ -307,8 +348,8 @@ func (suite *MultiWorkspaceIsolationV1TestSuite) BeforeAll(t provider.T) {
 		t.Fatalf("Failed to build Workspace B: %v", err)
 	}
 
-	stackA := suite.buildWorkspaceStack(t, workspaceAName, 1)
-	stackB := suite.buildWorkspaceStack(t, workspaceBName, 2)
+	stackA := suite.buildWorkspaceStack(t, workspaceAName)
+	stackB := suite.buildWorkspaceStack(t, workspaceBName)
 
 	p := &params.MultiWorkspaceIsolationV1Params{
 		Role:           role,
@@ -568,27 +609,7 @@ func (suite *MultiWorkspaceIsolationV1TestSuite) deleteStack(t provider.T, steps
 }
 
 func (suite *MultiWorkspaceIsolationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t,
-		sdkconsts.AuthorizationProviderV1Name,
-		sdkconsts.WorkspaceProviderV1Name,
-		sdkconsts.StorageProviderV1Name,
-		sdkconsts.ComputeProviderV1Name,
-		sdkconsts.NetworkProviderV1Name,
-	)
-	suite.ConfigureResources(t,
-		string(schema.GlobalTenantResourceMetadataKindResourceKindRole),
-		string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment),
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
-		string(schema.RegionalResourceMetadataKindResourceKindInstance),
-		string(schema.RegionalResourceMetadataKindResourceKindNetwork),
-		string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
-		string(schema.RegionalResourceMetadataKindResourceKindNic),
-		string(schema.RegionalResourceMetadataKindResourceKindPublicIP),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindSubnet),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroup),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -678,5 +699,6 @@ func (suite *MultiWorkspaceIsolationV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *MultiWorkspaceIsolationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
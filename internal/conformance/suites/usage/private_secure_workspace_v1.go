@@ -1,7 +1,6 @@
 package usage
 
 import (
-	"log/slog"
 	"math/rand"
 
 	"github.com/eu-sovereign-cloud/conformance/internal/conformance/params"
@@ -44,20 +43,55 @@ func CreatePrivateSecureWorkspaceV1TestSuite(regionalTestSuite suites.RegionalTe
 		config:            config,
 	}
 	suite.ScenarioName = constants.UsagePrivateSecureWorkspaceV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.NetworkProviderV1Name,
+		},
+		Skus: []string{
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.NetworkProviderV1Name,
+		},
+		Zones:       true,
+		NetworkCidr: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind: suites.UsageKind,
+		Providers: []string{
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.NetworkProviderV1Name,
+		},
+		Resources: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+			string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+			string(schema.RegionalResourceMetadataKindResourceKindInstance),
+			string(schema.RegionalResourceMetadataKindResourceKindNetwork),
+			string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+			string(schema.RegionalResourceMetadataKindResourceKindNic),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
+			string(schema.RegionalNetworkResourceMetadataKindResourceKindSubnet),
+			string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroup),
+		},
+	}
 	return suite
 }
 
 func (suite *PrivateSecureWorkspaceV1TestSuite) BeforeAll(t provider.T) {
 	t.AddParentSuite(suites.UsageParentSuite)
 
-	subnetCidr, err := generators.GenerateSubnetCidr(suite.config.NetworkCidr, 8, 1)
+	subnetCidr, err := generators.AllocateSubnetCidr(suite.config.NetworkCidr, 8)
 	if err != nil {
-		slog.Error("Failed to generate subnet cidr", "error", err)
+		suite.Logger().Error("Failed to generate subnet cidr", "error", err)
 		t.FailNow()
 	}
 	nicAddress, err := generators.GenerateNicAddress(subnetCidr, 1)
 	if err != nil {
-		slog.Error("Failed to generate nic address", "error", err)
+		suite.Logger().Error("Failed to generate nic address", "error", err)
 		t.FailNow()
 	}
 
@@ -231,23 +265,7 @@ func (suite *PrivateSecureWorkspaceV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *PrivateSecureWorkspaceV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t,
-		sdkconsts.WorkspaceProviderV1Name,
-		sdkconsts.StorageProviderV1Name,
-		sdkconsts.ComputeProviderV1Name,
-		sdkconsts.NetworkProviderV1Name,
-	)
-	suite.ConfigureResources(t,
-		string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
-		string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
-		string(schema.RegionalResourceMetadataKindResourceKindInstance),
-		string(schema.RegionalResourceMetadataKindResourceKindNetwork),
-		string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
-		string(schema.RegionalResourceMetadataKindResourceKindNic),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindRoutingTable),
-		string(schema.RegionalNetworkResourceMetadataKindResourceKindSubnet),
-		string(schema.RegionalWorkspaceResourceMetadataKindResourceKindSecurityGroup),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -475,5 +493,6 @@ func (suite *PrivateSecureWorkspaceV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *PrivateSecureWorkspaceV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -35,6 +35,14 @@ func CreateWorkspaceConstraintsValidationV1TestSuite(regionalTestSuite suites.Re
 		RegionalTestSuite: regionalTestSuite,
 	}
 	suite.ScenarioName = constants.WorkspaceConstraintsValidationV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name},
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ConstraintsKind,
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name},
+		Resources: []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+	}
 	return suite
 }
 
@@ -83,8 +91,7 @@ func (suite *WorkspaceConstraintsValidationV1TestSuite) BeforeAll(t provider.T)
 }
 
 func (suite *WorkspaceConstraintsValidationV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.WorkspaceProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -92,26 +99,31 @@ func (suite *WorkspaceConstraintsValidationV1TestSuite) TestScenario(t provider.
 		"Create a workspace with name exceeding maxLength:128 — expect rejection",
 		suite.Client.WorkspaceV1,
 		suite.params.OverLengthNameWorkspace,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateWorkspaceExpectViolationV1Step(
 		"Create a workspace with invalid name pattern (not kebab-case) — expect rejection",
 		suite.Client.WorkspaceV1,
 		suite.params.InvalidPatternNameWorkspace,
+		steps.ValidationError().At("/metadata/name"),
 	)
 	stepsBuilder.CreateOrUpdateWorkspaceExpectViolationV1Step(
 		"Create a workspace with label value exceeding maxLength:63 — expect rejection",
 		suite.Client.WorkspaceV1,
 		suite.params.OverLengthLabelValueWorkspace,
+		steps.ValidationError().At("/labels/constraint-test"),
 	)
 	stepsBuilder.CreateOrUpdateWorkspaceExpectViolationV1Step(
 		"Create a workspace with annotation value exceeding maxLength:1024 — expect rejection",
 		suite.Client.WorkspaceV1,
 		suite.params.OverLengthAnnotationWorkspace,
+		steps.ValidationError().At("/annotations/long-annotation"),
 	)
 
 	suite.FinishScenario()
 }
 
 func (suite *WorkspaceConstraintsValidationV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -42,6 +42,24 @@ func CreateWorkspaceErrorV1TestSuite(regionalTestSuite suites.RegionalTestSuite,
 		config:            config,
 	}
 	suite.ScenarioName = constants.WorkspaceErrorV1SuiteName.String()
+	suite.Requirements = suites.Requirements{
+		Providers: []string{
+			sdkconsts.WorkspaceProviderV1Name,
+			sdkconsts.ComputeProviderV1Name,
+			sdkconsts.StorageProviderV1Name,
+		},
+		Skus:  []string{sdkconsts.ComputeProviderV1Name, sdkconsts.StorageProviderV1Name},
+		Zones: true,
+	}
+	suite.Tags = suites.Tags{
+		Kind:      suites.ErrorKind,
+		Providers: []string{sdkconsts.WorkspaceProviderV1Name},
+		Resources: []string{string(schema.RegionalResourceMetadataKindResourceKindWorkspace)},
+		Depends: []string{
+			string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+			string(schema.RegionalResourceMetadataKindResourceKindInstance),
+		},
+	}
 	return suite
 }
 
@@ -132,12 +150,7 @@ func (suite *WorkspaceErrorV1TestSuite) BeforeAll(t provider.T) {
 }
 
 func (suite *WorkspaceErrorV1TestSuite) TestScenario(t provider.T) {
-	suite.StartScenario(t, sdkconsts.WorkspaceProviderV1Name)
-	suite.ConfigureResources(t, string(schema.RegionalResourceMetadataKindResourceKindWorkspace))
-	suite.ConfigureDepends(t,
-		string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
-		string(schema.RegionalResourceMetadataKindResourceKindInstance),
-	)
+	suite.StartScenario(t)
 
 	stepsBuilder := steps.NewStepsConfigurator(suite.TestSuite, t)
 
@@ -146,6 +159,7 @@ func (suite *WorkspaceErrorV1TestSuite) TestScenario(t provider.T) {
 		"Create a workspace with non-existent region — expect rejection",
 		suite.Client.WorkspaceV1,
 		suite.params.NonExistentRegionWorkspace,
+		steps.ValidationError().At("/metadata/region"),
 	)
 
 	// Delete conflict scenario — workspace with an active instance must not be deletable
@@ -253,6 +267,7 @@ func (suite *WorkspaceErrorV1TestSuite) TestScenario(t provider.T) {
 		"Delete a workspace with an active instance — expect 409 conflict",
 		suite.Client.WorkspaceV1,
 		workspace,
+		steps.ResourceConflictError(),
 	)
 
 	// Teardown — reverse dependency order
@@ -269,5 +284,6 @@ func (suite *WorkspaceErrorV1TestSuite) TestScenario(t provider.T) {
 }
 
 func (suite *WorkspaceErrorV1TestSuite) AfterAll(t provider.T) {
-	suite.ResetAllScenarios()
+	suite.CleanupResources(t)
+	suite.ResetScenario()
 }
@@ -24,6 +24,16 @@ var (
 	UpdatedResourceExpectedStates = []schema.ResourceState{schema.ResourceStateActive, schema.ResourceStateUpdating}
 )
 
+// ResourceStateTransitions are the states a resource may move to from each state, any other change is a regression
+var ResourceStateTransitions = map[schema.ResourceState][]schema.ResourceState{
+	schema.ResourceStatePending:  {schema.ResourceStateCreating, schema.ResourceStateActive, schema.ResourceStateDeleting, schema.ResourceStateError},
+	schema.ResourceStateCreating: {schema.ResourceStateActive, schema.ResourceStateDeleting, schema.ResourceStateError},
+	schema.ResourceStateActive:   {schema.ResourceStateUpdating, schema.ResourceStateDeleting, schema.ResourceStateError},
+	schema.ResourceStateUpdating: {schema.ResourceStateActive, schema.ResourceStateDeleting, schema.ResourceStateError},
+	schema.ResourceStateDeleting: {schema.ResourceStateError},
+	schema.ResourceStateError:    {schema.ResourceStateUpdating, schema.ResourceStateActive, schema.ResourceStateDeleting},
+}
+
 // Conditions
 var (
 	ActiveCondition = schema.StatusCondition{
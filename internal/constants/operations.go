@@ -1,5 +1,7 @@
 package constants
 
+import sdkconsts "github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
+
 type OperationName string
 
 const (
@@ -89,3 +91,48 @@ const (
 	CreateOrUpdateSecurityGroupOperation OperationName = "CreateOrUpdateSecurityGroup"
 	DeleteSecurityGroupOperation         OperationName = "DeleteSecurityGroup"
 )
+
+// ProviderV1Names lists the providers in the order the coverage is reported
+var ProviderV1Names = []string{
+	sdkconsts.RegionProviderV1Name,
+	sdkconsts.AuthorizationProviderV1Name,
+	sdkconsts.WorkspaceProviderV1Name,
+	sdkconsts.ComputeProviderV1Name,
+	sdkconsts.StorageProviderV1Name,
+	sdkconsts.NetworkProviderV1Name,
+}
+
+// ProviderV1Operations enumerates the operations of each provider, to find the ones never called
+var ProviderV1Operations = map[string][]OperationName{
+	sdkconsts.RegionProviderV1Name: {
+		ListRegionsOperation, GetRegionOperation,
+	},
+	sdkconsts.AuthorizationProviderV1Name: {
+		ListRolesOperation, GetRoleOperation, CreateOrUpdateRoleOperation, DeleteRoleOperation,
+		ListRoleAssignmentsOperation, GetRoleAssignmentOperation, CreateOrUpdateRoleAssignmentOperation, DeleteRoleAssignmentOperation,
+	},
+	sdkconsts.WorkspaceProviderV1Name: {
+		ListWorkspacesOperation, GetWorkspaceOperation, CreateOrUpdateWorkspaceOperation, DeleteWorkspaceOperation,
+	},
+	sdkconsts.ComputeProviderV1Name: {
+		ListSkusOperation, GetSkuOperation,
+		ListInstancesOperation, GetInstanceOperation, CreateOrUpdateInstanceOperation, DeleteInstanceOperation,
+		StartInstanceOperation, StopInstanceOperation, RestartInstanceOperation,
+	},
+	sdkconsts.StorageProviderV1Name: {
+		ListSkusOperation, GetSkuOperation,
+		ListBlockStorageOperation, GetBlockStorageOperation, CreateOrUpdateBlockStorageOperation, DeleteBlockStorageOperation,
+		ListImagesOperation, GetImageOperation, CreateOrUpdateImageOperation, DeleteImageOperation,
+	},
+	sdkconsts.NetworkProviderV1Name: {
+		ListSkusOperation, GetSkuOperation,
+		ListNetworksOperation, GetNetworkOperation, CreateOrUpdateNetworkOperation, DeleteNetworkOperation,
+		ListSubnetsOperation, GetSubnetOperation, CreateOrUpdateSubnetOperation, DeleteSubnetOperation,
+		ListRouteTablesOperation, GetRouteTableOperation, CreateOrUpdateRouteTableOperation, DeleteRouteTableOperation,
+		ListInternetGatewaysOperation, GetInternetGatewayOperation, CreateOrUpdateInternetGatewayOperation, DeleteInternetGatewayOperation,
+		ListSecurityGroupsOperation, GetSecurityGroupOperation, CreateOrUpdateSecurityGroupOperation, DeleteSecurityGroupOperation,
+		ListSecurityGroupRulesOperation, GetSecurityGroupRuleOperation, CreateOrUpdateSecurityGroupRuleOperation, DeleteSecurityGroupRuleOperation,
+		ListPublicIpsOperation, GetPublicIpOperation, CreateOrUpdatePublicIpOperation, DeletePublicIpOperation,
+		ListNicsOperation, GetNicOperation, CreateOrUpdateNicOperation, DeleteNicOperation,
+	},
+}
@@ -0,0 +1,34 @@
+package constants
+
+import (
+	"slices"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+// Resource kinds in the order they must be deleted, dependents before their dependencies
+var ResourceDeletionOrder = []string{
+	string(schema.RegionalResourceMetadataKindResourceKindInstance),
+	string(schema.RegionalResourceMetadataKindResourceKindNic),
+	string(schema.RegionalResourceMetadataKindResourceKindPublicIP),
+	string(schema.RegionalResourceMetadataKindResourceKindSecurityGroupRule),
+	string(schema.RegionalResourceMetadataKindResourceKindSecurityGroup),
+	string(schema.RegionalResourceMetadataKindResourceKindSubnet),
+	string(schema.RegionalResourceMetadataKindResourceKindRoutingTable),
+	string(schema.RegionalResourceMetadataKindResourceKindInternetGateway),
+	string(schema.RegionalResourceMetadataKindResourceKindNetwork),
+	string(schema.RegionalResourceMetadataKindResourceKindImage),
+	string(schema.RegionalResourceMetadataKindResourceKindBlockStorage),
+	string(schema.RegionalResourceMetadataKindResourceKindWorkspace),
+	string(schema.GlobalTenantResourceMetadataKindResourceKindRoleAssignment),
+	string(schema.GlobalTenantResourceMetadataKindResourceKindRole),
+}
+
+// ResourceDeletionRank returns the position of the kind in the deletion order, unknown kinds go last
+func ResourceDeletionRank(kind string) int {
+	rank := slices.Index(ResourceDeletionOrder, kind)
+	if rank < 0 {
+		return len(ResourceDeletionOrder)
+	}
+	return rank
+}
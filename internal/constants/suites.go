@@ -1,6 +1,141 @@
 package constants
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
 const (
 	// Internal Scenarios Names
 	ClientsInitScenarioName = "Clients.Init"
+
+	// Report Labels
+	RunIDReportLabel   = "runId"
+	ProfileReportLabel = "profile"
+	LevelReportLabel   = "level"
+	SloReportLabel     = "slo"
 )
+
+// ConformanceLevel groups the scenarios a provider must pass to claim conformance,
+// each level including the scenarios of the levels below it
+type ConformanceLevel string
+
+const (
+	CoreLevel     ConformanceLevel = "Core"
+	ExtendedLevel ConformanceLevel = "Extended"
+	FullLevel     ConformanceLevel = "Full"
+)
+
+// ConformanceLevels lists the levels from the lowest to the highest
+var ConformanceLevels = []ConformanceLevel{CoreLevel, ExtendedLevel, FullLevel}
+
+func (level ConformanceLevel) String() string {
+	return string(level)
+}
+
+// ParseConformanceLevel returns the level matching the name, ignoring case
+func ParseConformanceLevel(name string) (ConformanceLevel, error) {
+	for _, level := range ConformanceLevels {
+		if strings.EqualFold(name, level.String()) {
+			return level, nil
+		}
+	}
+
+	names := make([]string, 0, len(ConformanceLevels))
+	for _, level := range ConformanceLevels {
+		names = append(names, strings.ToLower(level.String()))
+	}
+	return "", fmt.Errorf("unknown conformance level %q, must be one of %s", name, strings.Join(names, ", "))
+}
+
+// Includes reports whether the scenarios of the other level are mandatory at this level
+func (level ConformanceLevel) Includes(other ConformanceLevel) bool {
+	return slices.Index(ConformanceLevels, other) <= slices.Index(ConformanceLevels, level)
+}
+
+// SuiteLevels assigns the level at which each suite becomes mandatory:
+// provider and resource lifecycles are Core, constraints and errors are Extended,
+// and the usage scenarios spanning several providers are Full
+var SuiteLevels = map[SuiteName]ConformanceLevel{
+	AuthorizationProviderLifeCycleV1SuiteName:      CoreLevel,
+	AuthorizationProviderQueriesV1SuiteName:        CoreLevel,
+	RoleLifeCycleV1SuiteName:                       CoreLevel,
+	RoleAssignmentLifeCycleV1SuiteName:             CoreLevel,
+	RoleConstraintsValidationV1SuiteName:           ExtendedLevel,
+	RoleAssignmentConstraintsValidationV1SuiteName: ExtendedLevel,
+	RoleErrorV1SuiteName:                           ExtendedLevel,
+	RoleAssignmentErrorV1SuiteName:                 ExtendedLevel,
+
+	RegionProviderQueriesV1SuiteName: CoreLevel,
+
+	WorkspaceProviderLifeCycleV1SuiteName:     CoreLevel,
+	WorkspaceProviderQueriesV1SuiteName:       CoreLevel,
+	WorkspaceConstraintsValidationV1SuiteName: ExtendedLevel,
+	WorkspaceErrorV1SuiteName:                 ExtendedLevel,
+
+	ComputeProviderLifeCycleV1SuiteName:      CoreLevel,
+	ComputeProviderQueriesV1SuiteName:        CoreLevel,
+	InstanceConstraintsValidationV1SuiteName: ExtendedLevel,
+	InstanceErrorV1SuiteName:                 ExtendedLevel,
+
+	StorageProviderLifeCycleV1SuiteName:          CoreLevel,
+	StorageProviderQueriesV1SuiteName:            CoreLevel,
+	BlockStorageLifeCycleV1SuiteName:             CoreLevel,
+	ImageLifeCycleV1SuiteName:                    CoreLevel,
+	BlockStorageConstraintsValidationV1SuiteName: ExtendedLevel,
+	ImageConstraintsValidationV1SuiteName:        ExtendedLevel,
+	BlockStorageErrorV1SuiteName:                 ExtendedLevel,
+	ImageErrorV1SuiteName:                        ExtendedLevel,
+
+	NetworkProviderLifeCycleV1SuiteName:               CoreLevel,
+	NetworkProviderQueriesV1SuiteName:                 CoreLevel,
+	NetworkLifeCycleV1SuiteName:                       CoreLevel,
+	SubnetLifeCycleV1SuiteName:                        CoreLevel,
+	SecurityGroupLifeCycleV1SuiteName:                 CoreLevel,
+	SecurityGroupRuleLifeCycleV1SuiteName:             CoreLevel,
+	InternetGatewayLifeCycleV1SuiteName:               CoreLevel,
+	PublicIpLifeCycleV1SuiteName:                      CoreLevel,
+	NicLifeCycleV1SuiteName:                           CoreLevel,
+	RouteTableLifeCycleV1SuiteName:                    CoreLevel,
+	NetworkConstraintsValidationV1SuiteName:           ExtendedLevel,
+	SubnetConstraintsValidationV1SuiteName:            ExtendedLevel,
+	SecurityGroupConstraintsValidationV1SuiteName:     ExtendedLevel,
+	SecurityGroupRuleConstraintsValidationV1SuiteName: ExtendedLevel,
+	InternetGatewayConstraintsValidationV1SuiteName:   ExtendedLevel,
+	PublicIpConstraintsValidationV1SuiteName:          ExtendedLevel,
+	NicConstraintsValidationV1SuiteName:               ExtendedLevel,
+	RouteTableConstraintsValidationV1SuiteName:        ExtendedLevel,
+	NetworkErrorV1SuiteName:                           ExtendedLevel,
+	SubnetErrorV1SuiteName:                            ExtendedLevel,
+	SecurityGroupErrorV1SuiteName:                     ExtendedLevel,
+	SecurityGroupRuleErrorV1SuiteName:                 ExtendedLevel,
+	InternetGatewayErrorV1SuiteName:                   ExtendedLevel,
+	PublicIpErrorV1SuiteName:                          ExtendedLevel,
+	NicErrorV1SuiteName:                               ExtendedLevel,
+	RouteTableErrorV1SuiteName:                        ExtendedLevel,
+
+	UsageFoundationProvidersV1SuiteName:     FullLevel,
+	UsageMultiWorkspaceIsolationV1SuiteName: FullLevel,
+	UsageHaMultiZoneV1SuiteName:             FullLevel,
+	UsagePrivateSecureWorkspaceV1SuiteName:  FullLevel,
+}
+
+// SuiteLevel returns the level of the suite, the highest one when it is not assigned
+func SuiteLevel(name string) ConformanceLevel {
+	if level, found := SuiteLevels[SuiteName(name)]; found {
+		return level
+	}
+	return FullLevel
+}
+
+// MandatorySuites lists the suites a provider must pass to be conformant at the level
+func MandatorySuites(level ConformanceLevel) []SuiteName {
+	var names []SuiteName
+	for _, name := range AllSuiteNames {
+		if level.Includes(SuiteLevel(name.String())) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -9,6 +9,11 @@ const (
 	EnvProductionLabel  = "production"
 	EnvConformanceLabel = "conformance"
 
+	RunIDLabel = "conformance-run-id"
+
+	ManagedByLabel         = "managed-by"
+	ManagedBySecatestLabel = "secatest"
+
 	ProviderLabel     = "Provider"
 	ProviderSecaLabel = "seca"
 
@@ -18,6 +23,9 @@ const (
 	ArchitectureLabel      = "Architecture"
 	ArchitectureAmd64Label = "amd64"
 
+	/// Annotations
+	RunIDAnnotation = "conformance-run-id"
+
 	/// Block Storage Sizes
 	BlockStorageInitialSize = 20 // GB
 	BlockStorageUpdatedSize = 30 // GB
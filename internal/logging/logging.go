@@ -0,0 +1,78 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Attributes carried by the records of a run, instead of being formatted into their messages
+const (
+	RunIDKey     = "runId"
+	ScenarioKey  = "scenario"
+	StepKey      = "step"
+	ProviderKey  = "provider"
+	OperationKey = "operation"
+)
+
+// Format of the log records
+type Format string
+
+const (
+	TextFormat Format = "text"
+	JSONFormat Format = "json"
+)
+
+// runIDPlaceholder is replaced in the log file path, to keep one file per run
+const runIDPlaceholder = "{run.id}"
+
+func ParseLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("unknown log level %q, must be one of debug, info, warn, error", name)
+	}
+}
+
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(strings.ToLower(name)); format {
+	case "":
+		return TextFormat, nil
+	case TextFormat, JSONFormat:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown log format %q, must be one of text, json", name)
+	}
+}
+
+func NewHandler(w io.Writer, level slog.Level, format Format) slog.Handler {
+	opts := &slog.HandlerOptions{Level: level}
+	if format == JSONFormat {
+		return slog.NewJSONHandler(w, opts)
+	}
+	return slog.NewTextHandler(w, opts)
+}
+
+// OpenFile opens the log file for appending, creating it and its directory when missing,
+// and replacing the {run.id} placeholder of its path by the run ID
+func OpenFile(path string, runID string) (*os.File, error) {
+	path = strings.ReplaceAll(path, runIDPlaceholder, runID)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create log file directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+	return file, nil
+}
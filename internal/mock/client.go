@@ -1,22 +1,48 @@
 package mock
 
-import "github.com/wiremock/go-wiremock"
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/wiremock/go-wiremock"
+)
 
 type MockClient struct {
 	Wiremock *wiremock.Client
-}
 
-func (client *MockClient) ResetAllScenarios() error {
-	return client.Wiremock.ResetAllScenarios()
+	url string
 }
 
-func NewMockClient(mockURL string) (*MockClient, error) {
-	wm := wiremock.NewClient(mockURL)
-	if err := wm.ResetAllScenarios(); err != nil {
-		return nil, err
+// ResetScenario moves a single scenario back to its started state, leaving the ones of concurrent suites untouched
+func (client *MockClient) ResetScenario(name string) error {
+	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf(scenarioStateURLFormat, client.url, url.PathEscape(name)), nil)
+	if err != nil {
+		return fmt.Errorf("build reset scenario request error: %w", err)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("reset scenario request error: %w", err)
+	}
+	defer res.Body.Close() //nolint:errcheck
+
+	// Scenarios without stubs yet are unknown to the server, so there is no state to reset
+	if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusNotFound {
+		return nil
 	}
 
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("read response error: %w", err)
+	}
+	return fmt.Errorf("bad response status: %d, response: %s", res.StatusCode, string(bodyBytes))
+}
+
+func NewMockClient(mockURL string) *MockClient {
 	return &MockClient{
-		Wiremock: wm,
-	}, nil
+		Wiremock: wiremock.NewClient(mockURL),
+		url:      mockURL,
+	}
 }
@@ -4,4 +4,10 @@ const (
 	// Http Headers
 	limitHeaderKey  = "Limit"
 	labelsHeaderKey = "Labels"
+
+	// Admin API
+	scenarioStateURLFormat = "%s/__admin/scenarios/%s/state"
+
+	// Matches the selectors appended after the expected label, like the run id
+	extraLabelsPattern = "(,.*)?"
 )
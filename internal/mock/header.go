@@ -8,13 +8,13 @@ func PathParamsLimit(limit string) map[string]string {
 
 func PathParamsLabel(labelKey string, labelValue string) map[string]string {
 	return map[string]string{
-		labelsHeaderKey: labelKey + "=" + labelValue,
+		labelsHeaderKey: labelKey + "=" + labelValue + extraLabelsPattern,
 	}
 }
 
 func PathParamsLimitAndLabel(limit string, labelKey string, labelValue string) map[string]string {
 	return map[string]string{
-		labelsHeaderKey: labelKey + "=" + labelValue,
+		labelsHeaderKey: labelKey + "=" + labelValue + extraLabelsPattern,
 		limitHeaderKey:  limit,
 	}
 }
@@ -28,7 +28,7 @@ func ConfigureRoleAssignmentErrorV1(scenario *mockscenarios.Scenario, p params.R
 		p.NonExistentRoleRefRoleAssignment.Metadata.Tenant,
 		p.NonExistentRoleRefRoleAssignment.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentRoleRefURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentRoleRefURL, scenario.MockParams, "/spec/roles/0"); err != nil {
 		return err
 	}
 
@@ -38,7 +38,7 @@ func ConfigureRoleAssignmentErrorV1(scenario *mockscenarios.Scenario, p params.R
 		p.NonExistentScopeTenantRoleAssignment.Metadata.Tenant,
 		p.NonExistentScopeTenantRoleAssignment.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentScopeTenantURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentScopeTenantURL, scenario.MockParams, "/spec/scopes/0/tenants/0"); err != nil {
 		return err
 	}
 
@@ -48,7 +48,7 @@ func ConfigureRoleAssignmentErrorV1(scenario *mockscenarios.Scenario, p params.R
 		p.NonExistentScopeRegionRoleAssignment.Metadata.Tenant,
 		p.NonExistentScopeRegionRoleAssignment.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentScopeRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentScopeRegionURL, scenario.MockParams, "/metadata/region"); err != nil {
 		return err
 	}
 
@@ -58,7 +58,7 @@ func ConfigureRoleAssignmentErrorV1(scenario *mockscenarios.Scenario, p params.R
 		p.NonExistentScopeWorkspaceRoleAssignment.Metadata.Tenant,
 		p.NonExistentScopeWorkspaceRoleAssignment.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentScopeWorkspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentScopeWorkspaceURL, scenario.MockParams, "/spec/scopes/0/workspaces/0"); err != nil {
 		return err
 	}
 
@@ -68,7 +68,7 @@ func ConfigureRoleAssignmentErrorV1(scenario *mockscenarios.Scenario, p params.R
 		p.NonExistentSubRoleAssignment.Metadata.Tenant,
 		p.NonExistentSubRoleAssignment.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentSubURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentSubURL, scenario.MockParams, "/spec/subs/0"); err != nil {
 		return err
 	}
 
@@ -20,33 +20,33 @@ func ConfigureRoleErrorV1(scenario *mockscenarios.Scenario, p params.RoleErrorV1
 		return err
 	}
 
-	// Invalid provider permission role — expect 412
+	// Invalid provider permission role — expect 422
 	invalidProviderURL := generators.GenerateRoleURL(
 		sdkconsts.AuthorizationProviderV1Name,
 		p.InvalidProviderPermissionRole.Metadata.Tenant,
 		p.InvalidProviderPermissionRole.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidProviderURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidProviderURL, scenario.MockParams, "/spec/permissions/0/provider"); err != nil {
 		return err
 	}
 
-	// Empty permissions role — expect 412
+	// Empty permissions role — expect 422
 	emptyPermissionsURL := generators.GenerateRoleURL(
 		sdkconsts.AuthorizationProviderV1Name,
 		p.EmptyPermissionsRole.Metadata.Tenant,
 		p.EmptyPermissionsRole.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(emptyPermissionsURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(emptyPermissionsURL, scenario.MockParams, "/spec/permissions"); err != nil {
 		return err
 	}
 
-	// Invalid verb role — expect 412
+	// Invalid verb role — expect 422
 	invalidVerbURL := generators.GenerateRoleURL(
 		sdkconsts.AuthorizationProviderV1Name,
 		p.InvalidVerbRole.Metadata.Tenant,
 		p.InvalidVerbRole.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidVerbURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidVerbURL, scenario.MockParams, "/spec/permissions/0/verb/0"); err != nil {
 		return err
 	}
 
@@ -56,7 +56,7 @@ func ConfigureInstanceConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverLengthNameInstance.Metadata.Workspace,
 		p.OverLengthNameInstance.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
@@ -67,7 +67,7 @@ func ConfigureInstanceConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.InvalidPatternNameInstance.Metadata.Workspace,
 		p.InvalidPatternNameInstance.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
@@ -78,7 +78,7 @@ func ConfigureInstanceConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverLengthLabelValueInstance.Metadata.Workspace,
 		p.OverLengthLabelValueInstance.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams, "/labels/constraint-test"); err != nil {
 		return err
 	}
 
@@ -89,7 +89,7 @@ func ConfigureInstanceConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverLengthAnnotationInstance.Metadata.Workspace,
 		p.OverLengthAnnotationInstance.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams, "/annotations/long-annotation"); err != nil {
 		return err
 	}
 
@@ -100,7 +100,7 @@ func ConfigureInstanceConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverLengthUserDataInstance.Metadata.Workspace,
 		p.OverLengthUserDataInstance.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthUserDataURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthUserDataURL, scenario.MockParams, "/spec/userData"); err != nil {
 		return err
 	}
 
@@ -111,7 +111,7 @@ func ConfigureInstanceConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverLengthAntiAffinityGroupInstance.Metadata.Workspace,
 		p.OverLengthAntiAffinityGroupInstance.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAntiAffinityGroupURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAntiAffinityGroupURL, scenario.MockParams, "/spec/antiAffinityGroup"); err != nil {
 		return err
 	}
 
@@ -122,7 +122,7 @@ func ConfigureInstanceConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverLengthSshKeyInstance.Metadata.Workspace,
 		p.OverLengthSshKeyInstance.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthSshKeyURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthSshKeyURL, scenario.MockParams, "/spec/sshKeys/0"); err != nil {
 		return err
 	}
 
@@ -133,7 +133,7 @@ func ConfigureInstanceConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverMaxItemsSshKeysInstance.Metadata.Workspace,
 		p.OverMaxItemsSshKeysInstance.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overMaxItemsSshKeysURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overMaxItemsSshKeysURL, scenario.MockParams, "/spec/sshKeys"); err != nil {
 		return err
 	}
 
@@ -144,7 +144,7 @@ func ConfigureInstanceConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.EmptySshKeyValueInstance.Metadata.Workspace,
 		p.EmptySshKeyValueInstance.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(emptySshKeyValueURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(emptySshKeyValueURL, scenario.MockParams, "/spec/sshKeys/0"); err != nil {
 		return err
 	}
 
@@ -155,7 +155,7 @@ func ConfigureInstanceConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverLengthZoneInstance.Metadata.Workspace,
 		p.OverLengthZoneInstance.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthZoneURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthZoneURL, scenario.MockParams, "/spec/zone"); err != nil {
 		return err
 	}
 
@@ -166,7 +166,7 @@ func ConfigureInstanceConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.EmptyZoneInstance.Metadata.Workspace,
 		p.EmptyZoneInstance.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(emptyZoneURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(emptyZoneURL, scenario.MockParams, "/spec/zone"); err != nil {
 		return err
 	}
 
@@ -177,7 +177,7 @@ func ConfigureInstanceConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverMaxItemsDataVolumesInstance.Metadata.Workspace,
 		p.OverMaxItemsDataVolumesInstance.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overMaxItemsDataVolumesURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overMaxItemsDataVolumesURL, scenario.MockParams, "/spec/dataVolumes"); err != nil {
 		return err
 	}
 
@@ -9,7 +9,8 @@ import (
 
 // ConfigureInstanceErrorV1 sets up mock stubs for the instance error scenarios suite.
 // Creates a valid workspace + block storage environment before testing
-// error scenarios, all invalid instance requests returning 422 Unprocessable Entity.
+// error scenarios, invalid instance requests returning 422 Unprocessable Entity,
+// or 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create instance with non-existent SKU
@@ -56,25 +57,25 @@ func ConfigureInstanceErrorV1(scenario *mockscenarios.Scenario, p params.Instanc
 
 	// Invalid SKU → 422
 	invalidSkuURL := generators.GenerateInstanceURL(sdkconsts.ComputeProviderV1Name, p.InvalidSkuInstance.Metadata.Tenant, workspace.Metadata.Name, p.InvalidSkuInstance.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidSkuURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidSkuURL, scenario.MockParams, "/spec/skuRef"); err != nil {
 		return err
 	}
 
-	// Non-existent workspace → 422
+	// Non-existent workspace → 404
 	nonExistentWorkspaceURL := generators.GenerateInstanceURL(sdkconsts.ComputeProviderV1Name, p.NonExistentWorkspaceInstance.Metadata.Tenant, p.NonExistentWorkspaceInstance.Metadata.Workspace, p.NonExistentWorkspaceInstance.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutNotFoundStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
 		return err
 	}
 
 	// Non-existent boot volume → 422
 	nonExistentBootVolumeURL := generators.GenerateInstanceURL(sdkconsts.ComputeProviderV1Name, p.NonExistentBootVolumeInstance.Metadata.Tenant, workspace.Metadata.Name, p.NonExistentBootVolumeInstance.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentBootVolumeURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentBootVolumeURL, scenario.MockParams, "/spec/bootVolume/deviceRef"); err != nil {
 		return err
 	}
 
 	// Invalid zone → 422
 	invalidZoneURL := generators.GenerateInstanceURL(sdkconsts.ComputeProviderV1Name, p.InvalidZoneInstance.Metadata.Tenant, workspace.Metadata.Name, p.InvalidZoneInstance.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidZoneURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidZoneURL, scenario.MockParams, "/spec/zone"); err != nil {
 		return err
 	}
 
@@ -105,8 +106,8 @@ func ConfigureInstanceErrorV1(scenario *mockscenarios.Scenario, p params.Instanc
 		return err
 	}
 
-	// Start on already-on instance → 412
-	if err := configurator.ConfigurePostUnprocessableEntityStub(powerInstanceStartURL, scenario.MockParams); err != nil {
+	// Start on already-on instance → 409
+	if err := configurator.ConfigurePostConflictStub(powerInstanceStartURL, scenario.MockParams); err != nil {
 		return err
 	}
 
@@ -115,13 +116,13 @@ func ConfigureInstanceErrorV1(scenario *mockscenarios.Scenario, p params.Instanc
 		return err
 	}
 
-	// Stop on already-off instance → 412
-	if err := configurator.ConfigurePostUnprocessableEntityStub(powerInstanceStopURL, scenario.MockParams); err != nil {
+	// Stop on already-off instance → 409
+	if err := configurator.ConfigurePostConflictStub(powerInstanceStopURL, scenario.MockParams); err != nil {
 		return err
 	}
 
-	// Restart on already-off instance → 412
-	if err := configurator.ConfigurePostUnprocessableEntityStub(powerInstanceRestartURL, scenario.MockParams); err != nil {
+	// Restart on already-off instance → 409
+	if err := configurator.ConfigurePostConflictStub(powerInstanceRestartURL, scenario.MockParams); err != nil {
 		return err
 	}
 
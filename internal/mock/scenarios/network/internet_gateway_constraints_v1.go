@@ -29,28 +29,28 @@ func ConfigureInternetGatewayConstraintsValidationV1(scenario *mockscenarios.Sce
 	// Over-length name Validation
 	overLengthNameInternetGateway := p.OverLengthNameInternetGateway
 	overLengthNameURL := generators.GenerateInternetGatewayURL(sdkconsts.NetworkProviderV1Name, overLengthNameInternetGateway.Metadata.Tenant, overLengthNameInternetGateway.Metadata.Workspace, overLengthNameInternetGateway.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
 	// Invalid pattern name Validation
 	invalidPatternNameInternetGateway := p.InvalidPatternNameInternetGateway
 	invalidPatternNameURL := generators.GenerateInternetGatewayURL(sdkconsts.NetworkProviderV1Name, invalidPatternNameInternetGateway.Metadata.Tenant, invalidPatternNameInternetGateway.Metadata.Workspace, invalidPatternNameInternetGateway.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
 	// Over-length label value Validation
 	overLengthLabelInternetGateway := p.OverLengthLabelValueInternetGateway
 	overLengthLabelURL := generators.GenerateInternetGatewayURL(sdkconsts.NetworkProviderV1Name, overLengthLabelInternetGateway.Metadata.Tenant, overLengthLabelInternetGateway.Metadata.Workspace, overLengthLabelInternetGateway.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams, "/labels/constraint-test"); err != nil {
 		return err
 	}
 
 	// Over-length annotation value Validation
 	overLengthAnnotationInternetGateway := p.OverLengthAnnotationInternetGateway
 	overLengthAnnotationURL := generators.GenerateInternetGatewayURL(sdkconsts.NetworkProviderV1Name, overLengthAnnotationInternetGateway.Metadata.Tenant, overLengthAnnotationInternetGateway.Metadata.Workspace, overLengthAnnotationInternetGateway.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams, "/annotations/long-annotation"); err != nil {
 		return err
 	}
 
@@ -7,9 +7,10 @@ import (
 	sdkconsts "github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
 )
 
-// ConfigureInternetGatewayErrorV1 sets up mock stubs for the internet gateway
-// error scenarios suite. Creates a valid workspace environment before testing
-// error scenarios, all invalid internet gateway requests returning 422 Unprocessable Entity.
+// ConfigureInternetGatewayErrorV1 sets up mock stubs for the internet gateway error scenarios suite.
+// Creates a valid workspace environment before testing
+// error scenarios, invalid internet gateway requests returning 422 Unprocessable Entity,
+// or 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create internet gateway with invalid region
@@ -41,7 +42,7 @@ func ConfigureInternetGatewayErrorV1(scenario *mockscenarios.Scenario, p params.
 		p.InvalidRegionInternetGateway.Metadata.Workspace,
 		p.InvalidRegionInternetGateway.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams, "/metadata/region"); err != nil {
 		return err
 	}
 
@@ -52,7 +53,7 @@ func ConfigureInternetGatewayErrorV1(scenario *mockscenarios.Scenario, p params.
 		p.NonExistentWorkspaceInternetGateway.Metadata.Workspace,
 		p.NonExistentWorkspaceInternetGateway.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutNotFoundStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
 		return err
 	}
 
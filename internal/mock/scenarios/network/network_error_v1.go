@@ -8,9 +8,10 @@ import (
 )
 
 // ConfigureNetworkErrorV1 sets up mock stubs for the network error scenarios suite.
-// Creates a valid workspace environment before testing error scenarios,
-// all invalid network requests returning 422 Unprocessable Entity, and deleting a
-// network that still has an active route table returns 409 Conflict.
+// Creates a valid workspace environment before testing
+// error scenarios, invalid network requests returning 422 Unprocessable Entity,
+// or 404 Not Found when a parent resource in the path does not exist,
+// and deleting a network that still has an active route table returns 409 Conflict.
 //
 // Scenarios tested:
 //   - Create network with invalid region
@@ -44,7 +45,7 @@ func ConfigureNetworkErrorV1(scenario *mockscenarios.Scenario, p params.NetworkE
 		p.InvalidRegionNetwork.Metadata.Workspace,
 		p.InvalidRegionNetwork.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams, "/metadata/region"); err != nil {
 		return err
 	}
 
@@ -55,7 +56,7 @@ func ConfigureNetworkErrorV1(scenario *mockscenarios.Scenario, p params.NetworkE
 		p.InvalidSkuNetwork.Metadata.Workspace,
 		p.InvalidSkuNetwork.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidSkuURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidSkuURL, scenario.MockParams, "/spec/skuRef"); err != nil {
 		return err
 	}
 
@@ -66,7 +67,7 @@ func ConfigureNetworkErrorV1(scenario *mockscenarios.Scenario, p params.NetworkE
 		p.NonExistentWorkspaceNetwork.Metadata.Workspace,
 		p.NonExistentWorkspaceNetwork.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutNotFoundStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
 		return err
 	}
 
@@ -113,7 +114,7 @@ func ConfigureNetworkErrorV1(scenario *mockscenarios.Scenario, p params.NetworkE
 	}
 
 	// Delete network while route table is active → 409
-	if err := configurator.ConfigureDeleteUnprocessableEntityStub(networkURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigureDeleteConflictStub(networkURL, scenario.MockParams); err != nil {
 		return err
 	}
 
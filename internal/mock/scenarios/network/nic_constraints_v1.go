@@ -34,7 +34,7 @@ func ConfigureNicConstraintsValidationV1(scenario *mockscenarios.Scenario, p par
 		p.OverLengthNameNic.Metadata.Workspace,
 		p.OverLengthNameNic.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
@@ -45,7 +45,7 @@ func ConfigureNicConstraintsValidationV1(scenario *mockscenarios.Scenario, p par
 		p.InvalidPatternNameNic.Metadata.Workspace,
 		p.InvalidPatternNameNic.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
@@ -56,7 +56,7 @@ func ConfigureNicConstraintsValidationV1(scenario *mockscenarios.Scenario, p par
 		p.OverLengthLabelValueNic.Metadata.Workspace,
 		p.OverLengthLabelValueNic.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams, "/labels/constraint-test"); err != nil {
 		return err
 	}
 
@@ -67,7 +67,7 @@ func ConfigureNicConstraintsValidationV1(scenario *mockscenarios.Scenario, p par
 		p.OverLengthAnnotationNic.Metadata.Workspace,
 		p.OverLengthAnnotationNic.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams, "/annotations/long-annotation"); err != nil {
 		return err
 	}
 
@@ -78,7 +78,7 @@ func ConfigureNicConstraintsValidationV1(scenario *mockscenarios.Scenario, p par
 		p.OverLengthAddressNic.Metadata.Workspace,
 		p.OverLengthAddressNic.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAddressURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAddressURL, scenario.MockParams, "/spec/addresses/0"); err != nil {
 		return err
 	}
 
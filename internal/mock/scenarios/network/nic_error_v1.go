@@ -8,9 +8,9 @@ import (
 )
 
 // ConfigureNicErrorV1 sets up mock stubs for the nic error scenarios suite.
-// Creates a valid workspace + network + internet gateway + route table + subnet
-// environment before testing error scenarios, all invalid nic requests returning
-// 422 Unprocessable Entity.
+// Creates a valid workspace + network + internet gateway + route table + subnet environment before testing
+// error scenarios, invalid nic requests returning 422 Unprocessable Entity,
+// or 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create nic with invalid region
@@ -100,7 +100,7 @@ func ConfigureNicErrorV1(scenario *mockscenarios.Scenario, p params.NicErrorV1Pa
 		p.InvalidRegionNic.Metadata.Workspace,
 		p.InvalidRegionNic.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams, "/metadata/region"); err != nil {
 		return err
 	}
 
@@ -111,7 +111,7 @@ func ConfigureNicErrorV1(scenario *mockscenarios.Scenario, p params.NicErrorV1Pa
 		p.NonExistentWorkspaceNic.Metadata.Workspace,
 		p.NonExistentWorkspaceNic.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutNotFoundStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
 		return err
 	}
 
@@ -122,7 +122,7 @@ func ConfigureNicErrorV1(scenario *mockscenarios.Scenario, p params.NicErrorV1Pa
 		p.NonExistentSubnetRefNic.Metadata.Workspace,
 		p.NonExistentSubnetRefNic.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentSubnetRefURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentSubnetRefURL, scenario.MockParams, "/spec/subnetRef"); err != nil {
 		return err
 	}
 
@@ -133,7 +133,7 @@ func ConfigureNicErrorV1(scenario *mockscenarios.Scenario, p params.NicErrorV1Pa
 		p.NonExistentPublicIpRefNic.Metadata.Workspace,
 		p.NonExistentPublicIpRefNic.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentPublicIpRefURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentPublicIpRefURL, scenario.MockParams, "/spec/publicIpRefs/0"); err != nil {
 		return err
 	}
 
@@ -34,7 +34,7 @@ func ConfigurePublicIpConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverLengthNamePublicIp.Metadata.Workspace,
 		p.OverLengthNamePublicIp.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
@@ -45,7 +45,7 @@ func ConfigurePublicIpConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.InvalidPatternNamePublicIp.Metadata.Workspace,
 		p.InvalidPatternNamePublicIp.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
@@ -56,7 +56,7 @@ func ConfigurePublicIpConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverLengthLabelValuePublicIp.Metadata.Workspace,
 		p.OverLengthLabelValuePublicIp.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams, "/labels/constraint-test"); err != nil {
 		return err
 	}
 
@@ -67,7 +67,7 @@ func ConfigurePublicIpConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverLengthAnnotationPublicIp.Metadata.Workspace,
 		p.OverLengthAnnotationPublicIp.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams, "/annotations/long-annotation"); err != nil {
 		return err
 	}
 
@@ -78,7 +78,7 @@ func ConfigurePublicIpConstraintsValidationV1(scenario *mockscenarios.Scenario,
 		p.OverLengthAddressPublicIp.Metadata.Workspace,
 		p.OverLengthAddressPublicIp.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAddressURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAddressURL, scenario.MockParams, "/spec/address"); err != nil {
 		return err
 	}
 
@@ -8,8 +8,9 @@ import (
 )
 
 // ConfigurePublicIpErrorV1 sets up mock stubs for the public ip error scenarios suite.
-// Creates a valid workspace environment before testing error scenarios,
-// all invalid public ip requests returning 422 Unprocessable Entity.
+// Creates a valid workspace environment before testing
+// error scenarios, invalid public ip requests returning 422 Unprocessable Entity,
+// or 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create public ip with invalid region
@@ -42,7 +43,7 @@ func ConfigurePublicIpErrorV1(scenario *mockscenarios.Scenario, p params.PublicI
 		p.InvalidRegionPublicIp.Metadata.Workspace,
 		p.InvalidRegionPublicIp.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams, "/metadata/region"); err != nil {
 		return err
 	}
 
@@ -53,7 +54,7 @@ func ConfigurePublicIpErrorV1(scenario *mockscenarios.Scenario, p params.PublicI
 		p.NonExistentWorkspacePublicIp.Metadata.Workspace,
 		p.NonExistentWorkspacePublicIp.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutNotFoundStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
 		return err
 	}
 
@@ -64,7 +65,7 @@ func ConfigurePublicIpErrorV1(scenario *mockscenarios.Scenario, p params.PublicI
 		p.InvalidVersionPublicIp.Metadata.Workspace,
 		p.InvalidVersionPublicIp.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidVersionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidVersionURL, scenario.MockParams, "/spec/version"); err != nil {
 		return err
 	}
 
@@ -55,35 +55,35 @@ func ConfigureRouteTableConstraintsValidationV1(scenario *mockscenarios.Scenario
 	// Over-length name Validation
 	overLengthNameRouteTable := p.OverLengthNameRouteTable
 	overLengthNameURL := generators.GenerateRouteTableURL(sdkconsts.NetworkProviderV1Name, overLengthNameRouteTable.Metadata.Tenant, overLengthNameRouteTable.Metadata.Workspace, overLengthNameRouteTable.Metadata.Network, overLengthNameRouteTable.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
 	// Invalid pattern name Validation
 	invalidPatternNameRouteTable := p.InvalidPatternNameRouteTable
 	invalidPatternNameURL := generators.GenerateRouteTableURL(sdkconsts.NetworkProviderV1Name, invalidPatternNameRouteTable.Metadata.Tenant, invalidPatternNameRouteTable.Metadata.Workspace, invalidPatternNameRouteTable.Metadata.Network, invalidPatternNameRouteTable.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
 	// Over-length label value Validation
 	overLengthLabelRouteTable := p.OverLengthLabelValueRouteTable
 	overLengthLabelURL := generators.GenerateRouteTableURL(sdkconsts.NetworkProviderV1Name, overLengthLabelRouteTable.Metadata.Tenant, overLengthLabelRouteTable.Metadata.Workspace, overLengthLabelRouteTable.Metadata.Network, overLengthLabelRouteTable.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams, "/labels/constraint-test"); err != nil {
 		return err
 	}
 
 	// Over-length annotation value Validation
 	overLengthAnnotationRouteTable := p.OverLengthAnnotationRouteTable
 	overLengthAnnotationURL := generators.GenerateRouteTableURL(sdkconsts.NetworkProviderV1Name, overLengthAnnotationRouteTable.Metadata.Tenant, overLengthAnnotationRouteTable.Metadata.Workspace, overLengthAnnotationRouteTable.Metadata.Network, overLengthAnnotationRouteTable.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams, "/annotations/long-annotation"); err != nil {
 		return err
 	}
 
 	// Over-length destinationCidrBlock Validation
 	overLengthDestinationCidrBlockRouteTable := p.OverLengthDestinationCidrBlockRouteTable
 	overLengthDestinationCidrBlockURL := generators.GenerateRouteTableURL(sdkconsts.NetworkProviderV1Name, overLengthDestinationCidrBlockRouteTable.Metadata.Tenant, overLengthDestinationCidrBlockRouteTable.Metadata.Workspace, overLengthDestinationCidrBlockRouteTable.Metadata.Network, overLengthDestinationCidrBlockRouteTable.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthDestinationCidrBlockURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthDestinationCidrBlockURL, scenario.MockParams, "/spec/routes/0/destinationCidrBlock"); err != nil {
 		return err
 	}
 
@@ -96,7 +96,7 @@ func ConfigureRouteTableConstraintsValidationV1(scenario *mockscenarios.Scenario
 		emptyRoutesRouteTable.Metadata.Network,
 		emptyRoutesRouteTable.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(emptyRoutesURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(emptyRoutesURL, scenario.MockParams, "/spec/routes"); err != nil {
 		return err
 	}
 
@@ -109,7 +109,7 @@ func ConfigureRouteTableConstraintsValidationV1(scenario *mockscenarios.Scenario
 		overMaxItemsRoutesRouteTable.Metadata.Network,
 		overMaxItemsRoutesRouteTable.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overMaxItemsRoutesURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overMaxItemsRoutesURL, scenario.MockParams, "/spec/routes"); err != nil {
 		return err
 	}
 
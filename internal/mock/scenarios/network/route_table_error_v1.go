@@ -9,7 +9,8 @@ import (
 
 // ConfigureRouteTableErrorV1 sets up mock stubs for the route table error scenarios suite.
 // Creates a valid workspace + network + internet gateway environment before testing
-// error scenarios, all invalid route table requests returning 422 Unprocessable Entity.
+// error scenarios, invalid route table requests returning 422 Unprocessable Entity,
+// or 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create route table with invalid region
@@ -72,7 +73,7 @@ func ConfigureRouteTableErrorV1(scenario *mockscenarios.Scenario, p params.Route
 		p.InvalidRegionRouteTable.Metadata.Network,
 		p.InvalidRegionRouteTable.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams, "/metadata/region"); err != nil {
 		return err
 	}
 
@@ -84,7 +85,7 @@ func ConfigureRouteTableErrorV1(scenario *mockscenarios.Scenario, p params.Route
 		p.NonExistentWorkspaceRouteTable.Metadata.Network,
 		p.NonExistentWorkspaceRouteTable.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutNotFoundStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
 		return err
 	}
 
@@ -96,7 +97,7 @@ func ConfigureRouteTableErrorV1(scenario *mockscenarios.Scenario, p params.Route
 		p.NonExistentNetworkRouteTable.Metadata.Network,
 		p.NonExistentNetworkRouteTable.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentNetworkURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutNotFoundStub(nonExistentNetworkURL, scenario.MockParams); err != nil {
 		return err
 	}
 
@@ -108,7 +109,7 @@ func ConfigureRouteTableErrorV1(scenario *mockscenarios.Scenario, p params.Route
 		p.NonExistentTargetRefRouteTable.Metadata.Network,
 		p.NonExistentTargetRefRouteTable.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentTargetRefURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentTargetRefURL, scenario.MockParams, "/spec/routes/0/targetRef"); err != nil {
 		return err
 	}
 
@@ -7,9 +7,10 @@ import (
 	sdkconsts "github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
 )
 
-// ConfigureSecurityGroupErrorV1 sets up mock stubs for the security group
-// error scenarios suite. Creates a valid workspace environment before testing
-// error scenarios, all invalid security group requests returning 422 Unprocessable Entity.
+// ConfigureSecurityGroupErrorV1 sets up mock stubs for the security group error scenarios suite.
+// Creates a valid workspace environment before testing
+// error scenarios, invalid security group requests returning 422 Unprocessable Entity,
+// or 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create security group with invalid region
@@ -42,7 +43,7 @@ func ConfigureSecurityGroupErrorV1(scenario *mockscenarios.Scenario, p params.Se
 		p.InvalidRegionSecurityGroup.Metadata.Workspace,
 		p.InvalidRegionSecurityGroup.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams, "/metadata/region"); err != nil {
 		return err
 	}
 
@@ -53,7 +54,7 @@ func ConfigureSecurityGroupErrorV1(scenario *mockscenarios.Scenario, p params.Se
 		p.NonExistentWorkspaceSecurityGroup.Metadata.Workspace,
 		p.NonExistentWorkspaceSecurityGroup.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutNotFoundStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
 		return err
 	}
 
@@ -64,7 +65,7 @@ func ConfigureSecurityGroupErrorV1(scenario *mockscenarios.Scenario, p params.Se
 		p.NonExistentRuleRefSecurityGroup.Metadata.Workspace,
 		p.NonExistentRuleRefSecurityGroup.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentRuleRefURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentRuleRefURL, scenario.MockParams, "/spec/ruleRefs/0"); err != nil {
 		return err
 	}
 
@@ -7,9 +7,10 @@ import (
 	sdkconsts "github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
 )
 
-// ConfigureSecurityGroupRuleErrorV1 sets up mock stubs for the security group rule
-// error scenarios suite. Creates a valid workspace environment before testing
-// error scenarios, all invalid security group rule requests returning 422 Unprocessable Entity.
+// ConfigureSecurityGroupRuleErrorV1 sets up mock stubs for the security group rule error scenarios suite.
+// Creates a valid workspace environment before testing
+// error scenarios, invalid security group rule requests returning 422 Unprocessable Entity,
+// or 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create security group rule with invalid region
@@ -41,7 +42,7 @@ func ConfigureSecurityGroupRuleErrorV1(scenario *mockscenarios.Scenario, p param
 		p.InvalidRegionSecurityGroupRule.Metadata.Workspace,
 		p.InvalidRegionSecurityGroupRule.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams, "/metadata/region"); err != nil {
 		return err
 	}
 
@@ -52,7 +53,7 @@ func ConfigureSecurityGroupRuleErrorV1(scenario *mockscenarios.Scenario, p param
 		p.NonExistentWorkspaceSecurityGroupRule.Metadata.Workspace,
 		p.NonExistentWorkspaceSecurityGroupRule.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutNotFoundStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
 		return err
 	}
 
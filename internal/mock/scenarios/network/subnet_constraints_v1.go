@@ -67,28 +67,28 @@ func ConfigureSubnetConstraintsValidationV1(scenario *mockscenarios.Scenario, p
 	// Over-length name Validation
 	overLengthNameSubnet := p.OverLengthNameSubnet
 	overLengthNameURL := generators.GenerateSubnetURL(sdkconsts.NetworkProviderV1Name, overLengthNameSubnet.Metadata.Tenant, overLengthNameSubnet.Metadata.Workspace, overLengthNameSubnet.Metadata.Network, overLengthNameSubnet.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
 	// Invalid pattern name Validation
 	invalidPatternNameSubnet := p.InvalidPatternNameSubnet
 	invalidPatternNameURL := generators.GenerateSubnetURL(sdkconsts.NetworkProviderV1Name, invalidPatternNameSubnet.Metadata.Tenant, invalidPatternNameSubnet.Metadata.Workspace, invalidPatternNameSubnet.Metadata.Network, invalidPatternNameSubnet.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
 	// Over-length label value Validation
 	overLengthLabelSubnet := p.OverLengthLabelValueSubnet
 	overLengthLabelURL := generators.GenerateSubnetURL(sdkconsts.NetworkProviderV1Name, overLengthLabelSubnet.Metadata.Tenant, overLengthLabelSubnet.Metadata.Workspace, overLengthLabelSubnet.Metadata.Network, overLengthLabelSubnet.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams, "/labels/constraint-test"); err != nil {
 		return err
 	}
 
 	// Over-length annotation value Validation
 	overLengthAnnotationSubnet := p.OverLengthAnnotationSubnet
 	overLengthAnnotationURL := generators.GenerateSubnetURL(sdkconsts.NetworkProviderV1Name, overLengthAnnotationSubnet.Metadata.Tenant, overLengthAnnotationSubnet.Metadata.Workspace, overLengthAnnotationSubnet.Metadata.Network, overLengthAnnotationSubnet.Metadata.Name)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams, "/annotations/long-annotation"); err != nil {
 		return err
 	}
 
@@ -101,7 +101,7 @@ func ConfigureSubnetConstraintsValidationV1(scenario *mockscenarios.Scenario, p
 		overLengthZoneSubnet.Metadata.Network,
 		overLengthZoneSubnet.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthZoneURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthZoneURL, scenario.MockParams, "/spec/zone"); err != nil {
 		return err
 	}
 
@@ -8,8 +8,9 @@ import (
 )
 
 // ConfigureSubnetErrorV1 sets up mock stubs for the subnet error scenarios suite.
-// Creates a valid workspace + network + internet gateway + route table environment
-// before testing error scenarios, all invalid subnet requests returning 422 Unprocessable Entity.
+// Creates a valid workspace + network + internet gateway + route table environment before testing
+// error scenarios, invalid subnet requests returning 422 Unprocessable Entity,
+// or 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create subnet with invalid region
@@ -88,7 +89,7 @@ func ConfigureSubnetErrorV1(scenario *mockscenarios.Scenario, p params.SubnetErr
 		p.InvalidRegionSubnet.Metadata.Network,
 		p.InvalidRegionSubnet.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams, "/metadata/region"); err != nil {
 		return err
 	}
 
@@ -100,7 +101,7 @@ func ConfigureSubnetErrorV1(scenario *mockscenarios.Scenario, p params.SubnetErr
 		p.InvalidZoneSubnet.Metadata.Network,
 		p.InvalidZoneSubnet.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidZoneURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidZoneURL, scenario.MockParams, "/spec/zone"); err != nil {
 		return err
 	}
 
@@ -112,7 +113,7 @@ func ConfigureSubnetErrorV1(scenario *mockscenarios.Scenario, p params.SubnetErr
 		p.NonExistentWorkspaceSubnet.Metadata.Network,
 		p.NonExistentWorkspaceSubnet.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutNotFoundStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
 		return err
 	}
 
@@ -124,7 +125,7 @@ func ConfigureSubnetErrorV1(scenario *mockscenarios.Scenario, p params.SubnetErr
 		p.NonExistentNetworkSubnet.Metadata.Network,
 		p.NonExistentNetworkSubnet.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentNetworkURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutNotFoundStub(nonExistentNetworkURL, scenario.MockParams); err != nil {
 		return err
 	}
 
@@ -136,7 +137,7 @@ func ConfigureSubnetErrorV1(scenario *mockscenarios.Scenario, p params.SubnetErr
 		p.NonExistentRouteTableRefSubnet.Metadata.Network,
 		p.NonExistentRouteTableRefSubnet.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentRouteTableRefURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentRouteTableRefURL, scenario.MockParams, "/spec/routeTableRef"); err != nil {
 		return err
 	}
 
@@ -148,7 +149,7 @@ func ConfigureSubnetErrorV1(scenario *mockscenarios.Scenario, p params.SubnetErr
 		p.OutsideCidrSubnet.Metadata.Network,
 		p.OutsideCidrSubnet.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(outsideCidrURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(outsideCidrURL, scenario.MockParams, "/spec/cidr/ipv4"); err != nil {
 		return err
 	}
 
@@ -46,7 +46,7 @@ func (scenario *Scenario) FinishConfiguration(stubsConfigurator *stubs.Configura
 
 func (scenario *Scenario) ResetScenario() error {
 	if scenario.client != nil {
-		if err := scenario.client.ResetAllScenarios(); err != nil {
+		if err := scenario.client.ResetScenario(scenario.Name); err != nil {
 			return fmt.Errorf("Failed to reset scenario: %w", err)
 		}
 	}
@@ -37,7 +37,7 @@ func ConfigureBlockStorageConstraintsV1(scenario *mockscenarios.Scenario, p para
 		p.OverLengthNameBlockStorage.Metadata.Workspace,
 		p.OverLengthNameBlockStorage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
@@ -48,7 +48,7 @@ func ConfigureBlockStorageConstraintsV1(scenario *mockscenarios.Scenario, p para
 		p.InvalidPatternNameBlockStorage.Metadata.Workspace,
 		p.InvalidPatternNameBlockStorage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
@@ -59,7 +59,7 @@ func ConfigureBlockStorageConstraintsV1(scenario *mockscenarios.Scenario, p para
 		p.OverLengthLabelValueBlockStorage.Metadata.Workspace,
 		p.OverLengthLabelValueBlockStorage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams, "/labels/constraint-test"); err != nil {
 		return err
 	}
 
@@ -70,7 +70,7 @@ func ConfigureBlockStorageConstraintsV1(scenario *mockscenarios.Scenario, p para
 		p.OverLengthAnnotationBlockStorage.Metadata.Workspace,
 		p.OverLengthAnnotationBlockStorage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams, "/annotations/long-annotation"); err != nil {
 		return err
 	}
 
@@ -81,7 +81,7 @@ func ConfigureBlockStorageConstraintsV1(scenario *mockscenarios.Scenario, p para
 		p.OverMaxSizeBlockStorage.Metadata.Workspace,
 		p.OverMaxSizeBlockStorage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overMaxSizeURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overMaxSizeURL, scenario.MockParams, "/spec/sizeGB"); err != nil {
 		return err
 	}
 
@@ -92,7 +92,7 @@ func ConfigureBlockStorageConstraintsV1(scenario *mockscenarios.Scenario, p para
 		p.ZeroSizeBlockStorage.Metadata.Workspace,
 		p.ZeroSizeBlockStorage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(zeroSizeURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(zeroSizeURL, scenario.MockParams, "/spec/sizeGB"); err != nil {
 		return err
 	}
 
@@ -7,9 +7,10 @@ import (
 	sdkconsts "github.com/eu-sovereign-cloud/go-sdk/pkg/constants"
 )
 
-// ConfigureBlockStorageErrorV1 sets up mock stubs for the block storage
-// error scenarios suite. Creates a valid workspace environment before testing
-// error scenarios, all invalid block storage requests returning 422 Unprocessable Entity.
+// ConfigureBlockStorageErrorV1 sets up mock stubs for the block storage error scenarios suite.
+// Creates a valid workspace environment before testing
+// error scenarios, invalid block storage requests returning 422 Unprocessable Entity,
+// or 404 Not Found when a parent resource in the path does not exist.
 //
 // Scenarios tested:
 //   - Create block storage with invalid region
@@ -42,7 +43,7 @@ func ConfigureBlockStorageErrorV1(scenario *mockscenarios.Scenario, p params.Blo
 		p.InvalidRegionBlockStorage.Metadata.Workspace,
 		p.InvalidRegionBlockStorage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams, "/metadata/region"); err != nil {
 		return err
 	}
 
@@ -53,7 +54,7 @@ func ConfigureBlockStorageErrorV1(scenario *mockscenarios.Scenario, p params.Blo
 		p.InvalidSkuBlockStorage.Metadata.Workspace,
 		p.InvalidSkuBlockStorage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidSkuURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidSkuURL, scenario.MockParams, "/spec/skuRef"); err != nil {
 		return err
 	}
 
@@ -65,7 +66,7 @@ func ConfigureBlockStorageErrorV1(scenario *mockscenarios.Scenario, p params.Blo
 		p.NonExistentWorkspaceBlockStorage.Metadata.Workspace,
 		p.NonExistentWorkspaceBlockStorage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutNotFoundStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
 		return err
 	}
 
@@ -50,7 +50,7 @@ func ConfigureImageConstraintsViolationsV1(scenario *mockscenarios.Scenario, p p
 		p.OverLengthNameImage.Metadata.Tenant,
 		p.OverLengthNameImage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
@@ -60,7 +60,7 @@ func ConfigureImageConstraintsViolationsV1(scenario *mockscenarios.Scenario, p p
 		p.InvalidPatternNameImage.Metadata.Tenant,
 		p.InvalidPatternNameImage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
@@ -70,7 +70,7 @@ func ConfigureImageConstraintsViolationsV1(scenario *mockscenarios.Scenario, p p
 		p.OverLengthLabelValueImage.Metadata.Tenant,
 		p.OverLengthLabelValueImage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams, "/labels/constraint-test"); err != nil {
 		return err
 	}
 
@@ -80,7 +80,7 @@ func ConfigureImageConstraintsViolationsV1(scenario *mockscenarios.Scenario, p p
 		p.OverLengthAnnotationImage.Metadata.Tenant,
 		p.OverLengthAnnotationImage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams, "/annotations/long-annotation"); err != nil {
 		return err
 	}
 
@@ -90,7 +90,7 @@ func ConfigureImageConstraintsViolationsV1(scenario *mockscenarios.Scenario, p p
 		p.InvalidCpuArchitectureImage.Metadata.Tenant,
 		p.InvalidCpuArchitectureImage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidCpuArchURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidCpuArchURL, scenario.MockParams, "/spec/cpuArchitecture"); err != nil {
 		return err
 	}
 
@@ -100,7 +100,7 @@ func ConfigureImageConstraintsViolationsV1(scenario *mockscenarios.Scenario, p p
 		p.InvalidInitializerImage.Metadata.Tenant,
 		p.InvalidInitializerImage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidInitializerURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidInitializerURL, scenario.MockParams, "/spec/initializer"); err != nil {
 		return err
 	}
 
@@ -110,7 +110,7 @@ func ConfigureImageConstraintsViolationsV1(scenario *mockscenarios.Scenario, p p
 		p.InvalidBootImage.Metadata.Tenant,
 		p.InvalidBootImage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidBootURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidBootURL, scenario.MockParams, "/spec/boot"); err != nil {
 		return err
 	}
 
@@ -57,7 +57,7 @@ func ConfigureImageErrorV1(scenario *mockscenarios.Scenario, p params.ImageError
 		p.InvalidRegionImage.Metadata.Tenant,
 		p.InvalidRegionImage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidRegionURL, scenario.MockParams, "/metadata/region"); err != nil {
 		return err
 	}
 
@@ -68,7 +68,7 @@ func ConfigureImageErrorV1(scenario *mockscenarios.Scenario, p params.ImageError
 		p.InvalidCpuArchitectureImage.Metadata.Tenant,
 		p.InvalidCpuArchitectureImage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidCpuArchURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidCpuArchURL, scenario.MockParams, "/spec/cpuArchitecture"); err != nil {
 		return err
 	}
 
@@ -79,7 +79,7 @@ func ConfigureImageErrorV1(scenario *mockscenarios.Scenario, p params.ImageError
 		p.CrossRegionBlockStorageImage.Metadata.Tenant,
 		p.CrossRegionBlockStorageImage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(crossRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(crossRegionURL, scenario.MockParams, "/spec/blockStorageRef"); err != nil {
 		return err
 	}
 
@@ -90,7 +90,7 @@ func ConfigureImageErrorV1(scenario *mockscenarios.Scenario, p params.ImageError
 		p.NonExistentWorkspaceImage.Metadata.Tenant,
 		p.NonExistentWorkspaceImage.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentWorkspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentWorkspaceURL, scenario.MockParams, "/spec/blockStorageRef"); err != nil {
 		return err
 	}
 
@@ -22,7 +22,7 @@ func ConfigureWorkspaceConstraintsViolationsV1(scenario *mockscenarios.Scenario,
 		p.OverLengthNameWorkspace.Metadata.Tenant,
 		p.OverLengthNameWorkspace.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
@@ -32,7 +32,7 @@ func ConfigureWorkspaceConstraintsViolationsV1(scenario *mockscenarios.Scenario,
 		p.InvalidPatternNameWorkspace.Metadata.Tenant,
 		p.InvalidPatternNameWorkspace.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(invalidPatternNameURL, scenario.MockParams, "/metadata/name"); err != nil {
 		return err
 	}
 
@@ -42,7 +42,7 @@ func ConfigureWorkspaceConstraintsViolationsV1(scenario *mockscenarios.Scenario,
 		p.OverLengthLabelValueWorkspace.Metadata.Tenant,
 		p.OverLengthLabelValueWorkspace.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthLabelURL, scenario.MockParams, "/labels/constraint-test"); err != nil {
 		return err
 	}
 
@@ -52,7 +52,7 @@ func ConfigureWorkspaceConstraintsViolationsV1(scenario *mockscenarios.Scenario,
 		p.OverLengthAnnotationWorkspace.Metadata.Tenant,
 		p.OverLengthAnnotationWorkspace.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(overLengthAnnotationURL, scenario.MockParams, "/annotations/long-annotation"); err != nil {
 		return err
 	}
 
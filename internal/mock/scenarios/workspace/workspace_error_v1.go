@@ -20,13 +20,13 @@ func ConfigureWorkspaceErrorV1(scenario *mockscenarios.Scenario, p params.Worksp
 		return err
 	}
 
-	// Non-existent region workspace — expect 412
+	// Non-existent region workspace — expect 422
 	nonExistentRegionURL := generators.GenerateWorkspaceURL(
 		sdkconsts.WorkspaceProviderV1Name,
 		p.NonExistentRegionWorkspace.Metadata.Tenant,
 		p.NonExistentRegionWorkspace.Metadata.Name,
 	)
-	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentRegionURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigurePutUnprocessableEntityStub(nonExistentRegionURL, scenario.MockParams, "/metadata/region"); err != nil {
 		return err
 	}
 
@@ -73,7 +73,7 @@ func ConfigureWorkspaceErrorV1(scenario *mockscenarios.Scenario, p params.Worksp
 	}
 
 	// Delete workspace while instance is active → 409
-	if err := configurator.ConfigureDeleteUnprocessableEntityStub(workspaceURL, scenario.MockParams); err != nil {
+	if err := configurator.ConfigureDeleteConflictStub(workspaceURL, scenario.MockParams); err != nil {
 		return err
 	}
 
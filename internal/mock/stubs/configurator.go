@@ -21,8 +21,9 @@ type Configurator struct {
 }
 
 func NewConfigurator(scenarioName string, params mock.MockParams) (*Configurator, error) {
-	client, err := mock.NewMockClient(params.ServerURL)
-	if err != nil {
+	// Start from a clean state, without touching the scenarios of concurrent suites
+	client := mock.NewMockClient(params.ServerURL)
+	if err := client.ResetScenario(scenarioName); err != nil {
 		return nil, err
 	}
 
@@ -109,24 +110,32 @@ func (configurator *Configurator) ConfigureDeleteStub(url string, params mock.Mo
 	return nil
 }
 
-func (configurator *Configurator) ConfigurePostUnprocessableEntityStub(url string, params mock.MockParams) error {
+func (configurator *Configurator) ConfigurePutUnprocessableEntityStub(url string, params mock.MockParams, pointer string) error {
 	return configurator.configureStub(func(wm *wiremock.Client, scenarioName string, sc *stubConfig) error {
-		sc.httpMethod = http.MethodPost
-		return configurePreconditionFailedStub(wm, scenarioName, sc)
+		sc.httpMethod = http.MethodPut
+		sc.errorPointer = pointer
+		return configureUnprocessableEntityStub(wm, scenarioName, sc)
 	}, url, params, nil, nil)
 }
 
-func (configurator *Configurator) ConfigurePutUnprocessableEntityStub(url string, params mock.MockParams) error {
+func (configurator *Configurator) ConfigurePutNotFoundStub(url string, params mock.MockParams) error {
 	return configurator.configureStub(func(wm *wiremock.Client, scenarioName string, sc *stubConfig) error {
 		sc.httpMethod = http.MethodPut
-		return configurePreconditionFailedStub(wm, scenarioName, sc)
+		return configureNotFoundStub(wm, scenarioName, sc)
+	}, url, params, nil, nil)
+}
+
+func (configurator *Configurator) ConfigurePostConflictStub(url string, params mock.MockParams) error {
+	return configurator.configureStub(func(wm *wiremock.Client, scenarioName string, sc *stubConfig) error {
+		sc.httpMethod = http.MethodPost
+		return configureConflictStub(wm, scenarioName, sc)
 	}, url, params, nil, nil)
 }
 
-func (configurator *Configurator) ConfigureDeleteUnprocessableEntityStub(url string, params mock.MockParams) error {
+func (configurator *Configurator) ConfigureDeleteConflictStub(url string, params mock.MockParams) error {
 	return configurator.configureStub(func(wm *wiremock.Client, scenarioName string, sc *stubConfig) error {
 		sc.httpMethod = http.MethodDelete
-		return configurePreconditionFailedStub(wm, scenarioName, sc)
+		return configureConflictStub(wm, scenarioName, sc)
 	}, url, params, nil, nil)
 }
 
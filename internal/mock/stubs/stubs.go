@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/eu-sovereign-cloud/conformance/internal/mock"
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
 	"github.com/wiremock/go-wiremock"
 )
 
@@ -19,6 +20,7 @@ type stubConfig struct {
 	currentState string
 	nextState    string
 	priority     int
+	errorPointer string
 }
 
 func configureStub(wm *wiremock.Client, scenarioName string, stubConfig *stubConfig) error {
@@ -107,8 +109,7 @@ func ConfigureGetStub(wm *wiremock.Client, scenarioName string, stubConfig *stub
 
 func configureGetNotFoundStub(wm *wiremock.Client, scenarioName string, stubConfig *stubConfig) error {
 	stubConfig.httpMethod = http.MethodGet
-	stubConfig.httpStatus = http.StatusNotFound
-	return configureStub(wm, scenarioName, stubConfig)
+	return configureNotFoundStub(wm, scenarioName, stubConfig)
 }
 
 func configureDeleteStub(wm *wiremock.Client, scenarioName string, stubConfig *stubConfig) error {
@@ -117,8 +118,34 @@ func configureDeleteStub(wm *wiremock.Client, scenarioName string, stubConfig *s
 	return configureStub(wm, scenarioName, stubConfig)
 }
 
-func configurePreconditionFailedStub(wm *wiremock.Client, scenarioName string, stubConfig *stubConfig) error {
-	stubConfig.httpStatus = http.StatusPreconditionFailed
+func configureUnprocessableEntityStub(wm *wiremock.Client, scenarioName string, stubConfig *stubConfig) error {
+	return configureErrorStub(wm, scenarioName, stubConfig, http.StatusUnprocessableEntity, schema.ErrorTypeValidationError)
+}
+
+func configureNotFoundStub(wm *wiremock.Client, scenarioName string, stubConfig *stubConfig) error {
+	return configureErrorStub(wm, scenarioName, stubConfig, http.StatusNotFound, schema.ErrorTypeResourceNotFound)
+}
+
+func configureConflictStub(wm *wiremock.Client, scenarioName string, stubConfig *stubConfig) error {
+	return configureErrorStub(wm, scenarioName, stubConfig, http.StatusConflict, schema.ErrorTypeResourceConflict)
+}
+
+// configureErrorStub responds with the problem details of the error type, pointing at the rejected field if any
+func configureErrorStub(wm *wiremock.Client, scenarioName string, stubConfig *stubConfig, httpStatus int, errorType schema.ErrorType) error {
+	sources := []schema.ErrorSource{}
+	if stubConfig.errorPointer != "" {
+		sources = append(sources, schema.ErrorSource{Pointer: stubConfig.errorPointer})
+	}
+
+	stubConfig.httpStatus = httpStatus
+	stubConfig.responseBody = schema.Error{
+		Type:     string(errorType),
+		Status:   float32(httpStatus),
+		Title:    http.StatusText(httpStatus),
+		Detail:   "The request was rejected by the mock",
+		Instance: stubConfig.url,
+		Sources:  sources,
+	}
 	return configureStub(wm, scenarioName, stubConfig)
 }
 
@@ -1,13 +1,19 @@
 package report
 
 type allureResult struct {
-	Name          string       `json:"name"`
-	FullName      string       `json:"fullName"`
-	Status        string       `json:"status"`
-	StatusDetails allureDetail `json:"statusDetails"`
-	Start         int64        `json:"start"`
-	Stop          int64        `json:"stop"`
-	Steps         []allureStep `json:"steps"`
+	Name          string        `json:"name"`
+	FullName      string        `json:"fullName"`
+	Status        string        `json:"status"`
+	StatusDetails allureDetail  `json:"statusDetails"`
+	Start         int64         `json:"start"`
+	Stop          int64         `json:"stop"`
+	Steps         []allureStep  `json:"steps"`
+	Labels        []allureLabel `json:"labels"`
+}
+
+type allureLabel struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
 }
 
 type allureDetail struct {
@@ -16,10 +22,23 @@ type allureDetail struct {
 }
 
 type allureStep struct {
-	Name          string       `json:"name"`
-	Status        string       `json:"status"`
-	StatusDetails allureDetail `json:"statusDetails"`
-	Start         int64        `json:"start"`
-	Stop          int64        `json:"stop"`
-	Steps         []allureStep `json:"steps"`
+	Name          string             `json:"name"`
+	Status        string             `json:"status"`
+	StatusDetails allureDetail       `json:"statusDetails"`
+	Start         int64              `json:"start"`
+	Stop          int64              `json:"stop"`
+	Steps         []allureStep       `json:"steps"`
+	Parameters    []allureParameter  `json:"parameters"`
+	Attachments   []allureAttachment `json:"attachments"`
+}
+
+type allureAttachment struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+	Type   string `json:"type"`
+}
+
+type allureParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
 }
@@ -0,0 +1,158 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+)
+
+// Step parameters naming the provider and the operation of a call, as set by the suites
+const (
+	providerParameter  = "provider"
+	operationParameter = "operation"
+)
+
+// Coverage counts the calls of every provider operation found in the steps of the results
+type Coverage struct {
+	Operations int                `json:"operations"`
+	Exercised  int                `json:"exercised"`
+	Providers  []ProviderCoverage `json:"providers"`
+}
+
+type ProviderCoverage struct {
+	Provider   string              `json:"provider"`
+	Operations int                 `json:"operations"`
+	Exercised  int                 `json:"exercised"`
+	Coverage   []OperationCoverage `json:"coverage"`
+}
+
+type OperationCoverage struct {
+	Operation string `json:"operation"`
+	Calls     int    `json:"calls"`
+	Passed    int    `json:"passed"`
+	Failed    int    `json:"failed"`
+	Broken    int    `json:"broken"`
+	Skipped   int    `json:"skipped"`
+}
+
+// BuildCoverage matches the operations called by the steps against the enumerated ones,
+// keeping the operations never called, and listing the ones not enumerated after them
+func BuildCoverage(s *Summary) *Coverage {
+	calls := map[string]map[string]*OperationCoverage{}
+	for _, sc := range s.Scenarios {
+		countOperations(calls, sc.Steps)
+	}
+
+	providers := slices.Clone(constants.ProviderV1Names)
+	for provider := range calls {
+		if !slices.Contains(providers, provider) {
+			providers = append(providers, provider)
+		}
+	}
+	slices.Sort(providers[len(constants.ProviderV1Names):])
+
+	coverage := &Coverage{}
+	for _, provider := range providers {
+		operations := make([]string, 0, len(constants.ProviderV1Operations[provider]))
+		for _, operation := range constants.ProviderV1Operations[provider] {
+			operations = append(operations, string(operation))
+		}
+		known := len(operations)
+		for operation := range calls[provider] {
+			if !slices.Contains(operations, operation) {
+				operations = append(operations, operation)
+			}
+		}
+		slices.Sort(operations[known:])
+
+		providerCoverage := ProviderCoverage{Provider: provider}
+		for _, operation := range operations {
+			operationCoverage := OperationCoverage{Operation: operation}
+			if counted, found := calls[provider][operation]; found {
+				operationCoverage = *counted
+				providerCoverage.Exercised++
+			}
+			providerCoverage.Coverage = append(providerCoverage.Coverage, operationCoverage)
+			providerCoverage.Operations++
+		}
+
+		coverage.Operations += providerCoverage.Operations
+		coverage.Exercised += providerCoverage.Exercised
+		coverage.Providers = append(coverage.Providers, providerCoverage)
+	}
+	return coverage
+}
+
+func countOperations(calls map[string]map[string]*OperationCoverage, steps []StepResult) {
+	for _, step := range steps {
+		provider, operation := parameterValue(step.Parameters, providerParameter), parameterValue(step.Parameters, operationParameter)
+		if provider != "" && operation != "" {
+			if calls[provider] == nil {
+				calls[provider] = map[string]*OperationCoverage{}
+			}
+			counted, found := calls[provider][operation]
+			if !found {
+				counted = &OperationCoverage{Operation: operation}
+				calls[provider][operation] = counted
+			}
+
+			counted.Calls++
+			switch step.Status {
+			case statusPassed:
+				counted.Passed++
+			case statusFailed:
+				counted.Failed++
+			case statusBroken:
+				counted.Broken++
+			case statusSkipped:
+				counted.Skipped++
+			}
+		}
+		countOperations(calls, step.Steps)
+	}
+}
+
+func parameterValue(parameters []Parameter, name string) string {
+	for _, parameter := range parameters {
+		if parameter.Name == name {
+			return parameter.Value
+		}
+	}
+	return ""
+}
+
+func WriteCoverageText(w io.Writer, coverage *Coverage) error {
+	if _, err := fmt.Fprintf(w, "Operation Coverage — %d/%d operations exercised\n", coverage.Exercised, coverage.Operations); err != nil {
+		return err
+	}
+
+	for _, provider := range coverage.Providers {
+		if _, err := fmt.Fprintf(w, "\n%s  %d/%d exercised\n", provider.Provider, provider.Exercised, provider.Operations); err != nil {
+			return err
+		}
+
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		if _, err := fmt.Fprintln(tw, "OPERATION\tCALLS\tPASSED\tFAILED\tBROKEN\tSKIPPED"); err != nil {
+			return err
+		}
+		for _, operation := range provider.Coverage {
+			if operation.Calls == 0 {
+				if _, err := fmt.Fprintf(tw, "%s\t0\t-\t-\t-\t-\tnever called\n", operation.Operation); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%d\n",
+				operation.Operation, operation.Calls, operation.Passed, operation.Failed, operation.Broken, operation.Skipped); err != nil {
+				return err
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
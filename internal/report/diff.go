@@ -0,0 +1,294 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Change classifies a scenario, or a step path within it, between two runs
+type Change string
+
+const (
+	NewlyFailingChange Change = "newly-failing"
+	FixedChange        Change = "fixed"
+	StillFailingChange Change = "still-failing"
+	NewChange          Change = "new"
+	RemovedChange      Change = "removed"
+)
+
+// DiffOptions sets when a longer scenario duration is reported as a regression
+type DiffOptions struct {
+	ThresholdPercent float64
+	MinIncreaseMs    int64
+}
+
+type Diff struct {
+	OldRunID            string               `json:"old_run_id,omitempty"`
+	NewRunID            string               `json:"new_run_id,omitempty"`
+	Totals              DiffTotals           `json:"totals"`
+	Scenarios           []ScenarioChange     `json:"scenarios"`
+	DurationRegressions []DurationRegression `json:"duration_regressions"`
+}
+
+type DiffTotals struct {
+	NewlyFailing        int `json:"newly_failing"`
+	Fixed               int `json:"fixed"`
+	StillFailing        int `json:"still_failing"`
+	New                 int `json:"new"`
+	Removed             int `json:"removed"`
+	DurationRegressions int `json:"duration_regressions"`
+}
+
+type ScenarioChange struct {
+	Name      string       `json:"name"`
+	Change    Change       `json:"change,omitempty"`
+	OldStatus string       `json:"old_status,omitempty"`
+	NewStatus string       `json:"new_status,omitempty"`
+	Steps     []StepChange `json:"steps,omitempty"`
+}
+
+type StepChange struct {
+	Path      string `json:"path"`
+	Change    Change `json:"change"`
+	OldStatus string `json:"old_status,omitempty"`
+	NewStatus string `json:"new_status,omitempty"`
+}
+
+type DurationRegression struct {
+	Name            string  `json:"name"`
+	OldDurationMs   int64   `json:"old_duration_ms"`
+	NewDurationMs   int64   `json:"new_duration_ms"`
+	IncreasePercent float64 `json:"increase_percent"`
+}
+
+// NewFailures counts the scenarios failing in the new run that did not fail in the old one
+func (diff *Diff) NewFailures() int {
+	count := diff.Totals.NewlyFailing
+	for _, sc := range diff.Scenarios {
+		if sc.Change == NewChange && isFailing(sc.NewStatus) {
+			count++
+		}
+	}
+	return count
+}
+
+// BuildDiff compares the scenarios of two runs, and the step paths of the scenarios failing in either
+func BuildDiff(oldSummary *Summary, newSummary *Summary, options DiffOptions) *Diff {
+	diff := &Diff{
+		OldRunID:            oldSummary.RunID,
+		NewRunID:            newSummary.RunID,
+		Scenarios:           []ScenarioChange{},
+		DurationRegressions: []DurationRegression{},
+	}
+
+	oldScenarios := scenariosByName(oldSummary.Scenarios)
+	newScenarios := scenariosByName(newSummary.Scenarios)
+
+	names := map[string]bool{}
+	for name := range oldScenarios {
+		names[name] = true
+	}
+	for name := range newScenarios {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		oldScenario, inOld := oldScenarios[name]
+		newScenario, inNew := newScenarios[name]
+
+		sc := ScenarioChange{Name: name, OldStatus: oldScenario.Status, NewStatus: newScenario.Status}
+		switch {
+		case !inOld:
+			sc.Change = NewChange
+		case !inNew:
+			sc.Change = RemovedChange
+		default:
+			sc.Change = classify(oldScenario.Status, newScenario.Status)
+			// Passing steps only differ by their retries, so only failing scenarios are compared
+			if isFailing(oldScenario.Status) || isFailing(newScenario.Status) {
+				sc.Steps = diffSteps(oldScenario.Steps, newScenario.Steps)
+			}
+
+			if regression, found := durationRegression(oldScenario, newScenario, options); found {
+				diff.DurationRegressions = append(diff.DurationRegressions, regression)
+			}
+		}
+
+		if sc.Change == "" && len(sc.Steps) == 0 {
+			continue
+		}
+		diff.Totals.add(sc.Change)
+		diff.Scenarios = append(diff.Scenarios, sc)
+	}
+	diff.Totals.DurationRegressions = len(diff.DurationRegressions)
+
+	return diff
+}
+
+func (totals *DiffTotals) add(change Change) {
+	switch change {
+	case NewlyFailingChange:
+		totals.NewlyFailing++
+	case FixedChange:
+		totals.Fixed++
+	case StillFailingChange:
+		totals.StillFailing++
+	case NewChange:
+		totals.New++
+	case RemovedChange:
+		totals.Removed++
+	}
+}
+
+func scenariosByName(scenarios []ScenarioResult) map[string]ScenarioResult {
+	byName := make(map[string]ScenarioResult, len(scenarios))
+	for _, sc := range scenarios {
+		byName[sc.Name] = sc
+	}
+	return byName
+}
+
+func isFailing(status string) bool {
+	return status == statusFailed || status == statusBroken
+}
+
+// classify returns no change when the status did not move between passing and failing
+func classify(oldStatus string, newStatus string) Change {
+	switch {
+	case isFailing(oldStatus) && isFailing(newStatus):
+		return StillFailingChange
+	case isFailing(newStatus):
+		return NewlyFailingChange
+	case isFailing(oldStatus) && newStatus == statusPassed:
+		return FixedChange
+	default:
+		return ""
+	}
+}
+
+func diffSteps(oldSteps []StepResult, newSteps []StepResult) []StepChange {
+	oldPaths, newPaths := map[string]string{}, map[string]string{}
+	oldOrder := flattenSteps(oldPaths, "", oldSteps)
+	order := flattenSteps(newPaths, "", newSteps)
+
+	// Steps keep the order of the new run, followed by the removed ones
+	for _, path := range oldOrder {
+		if _, found := newPaths[path]; !found {
+			order = append(order, path)
+		}
+	}
+
+	var changes []StepChange
+	for _, path := range order {
+		oldStatus, inOld := oldPaths[path]
+		newStatus, inNew := newPaths[path]
+
+		change := StepChange{Path: path, OldStatus: oldStatus, NewStatus: newStatus}
+		switch {
+		case !inOld:
+			change.Change = NewChange
+		case !inNew:
+			change.Change = RemovedChange
+		default:
+			change.Change = classify(oldStatus, newStatus)
+		}
+		if change.Change != "" {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// flattenSteps records the status of every step by its path of names, numbering repeated
+// sibling names, and returns the paths in the order of the steps
+func flattenSteps(paths map[string]string, prefix string, steps []StepResult) []string {
+	var order []string
+	seen := map[string]int{}
+	for _, step := range steps {
+		seen[step.Name]++
+		name := step.Name
+		if seen[step.Name] > 1 {
+			name = fmt.Sprintf("%s [%d]", step.Name, seen[step.Name])
+		}
+
+		path := name
+		if prefix != "" {
+			path = prefix + " > " + name
+		}
+		paths[path] = step.Status
+		order = append(order, path)
+		order = append(order, flattenSteps(paths, path, step.Steps)...)
+	}
+	return order
+}
+
+func durationRegression(oldScenario ScenarioResult, newScenario ScenarioResult, options DiffOptions) (DurationRegression, bool) {
+	increase := newScenario.DurationMs - oldScenario.DurationMs
+	if oldScenario.DurationMs <= 0 || increase <= 0 || increase < options.MinIncreaseMs {
+		return DurationRegression{}, false
+	}
+
+	percent := float64(increase) * 100 / float64(oldScenario.DurationMs)
+	if percent < options.ThresholdPercent {
+		return DurationRegression{}, false
+	}
+	return DurationRegression{
+		Name:            newScenario.Name,
+		OldDurationMs:   oldScenario.DurationMs,
+		NewDurationMs:   newScenario.DurationMs,
+		IncreasePercent: percent,
+	}, true
+}
+
+func WriteDiffText(w io.Writer, diff *Diff) error {
+	if _, err := fmt.Fprintf(w, "Conformance Diff — %s → %s\n", orDash(diff.OldRunID), orDash(diff.NewRunID)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Newly failing: %d  Fixed: %d  Still failing: %d  New: %d  Removed: %d  Duration regressions: %d\n\n",
+		diff.Totals.NewlyFailing, diff.Totals.Fixed, diff.Totals.StillFailing, diff.Totals.New, diff.Totals.Removed, diff.Totals.DurationRegressions); err != nil {
+		return err
+	}
+
+	for _, sc := range diff.Scenarios {
+		change := sc.Change
+		if change == "" {
+			change = "changed"
+		}
+		if _, err := fmt.Fprintf(w, "%-14s %s  (%s → %s)\n", strings.ToUpper(string(change)), sc.Name, orDash(sc.OldStatus), orDash(sc.NewStatus)); err != nil {
+			return err
+		}
+		for _, step := range sc.Steps {
+			if _, err := fmt.Fprintf(w, "  %-14s %s  (%s → %s)\n", strings.ToUpper(string(step.Change)), step.Path, orDash(step.OldStatus), orDash(step.NewStatus)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(diff.DurationRegressions) == 0 {
+		return nil
+	}
+	if _, err := fmt.Fprintln(w, "\nDuration regressions:"); err != nil {
+		return err
+	}
+	for _, regression := range diff.DurationRegressions {
+		if _, err := fmt.Fprintf(w, "  %s  %d ms → %d ms  (+%.0f%%)\n",
+			regression.Name, regression.OldDurationMs, regression.NewDurationMs, regression.IncreasePercent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,40 @@
+package report
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+)
+
+//go:embed html.tmpl
+var htmlTemplate string
+
+var htmlReport = template.Must(template.New("report").Funcs(template.FuncMap{
+	"upper":   strings.ToUpper,
+	"seconds": formatSeconds,
+	"pretty":  prettyJSON,
+	"failing": func(status string) bool { return status == statusFailed || status == statusBroken },
+}).Parse(htmlTemplate))
+
+// WriteHTML renders the summary as a single static HTML page, with collapsible steps,
+// their recorded parameters and a filter by status, viewable without the Allure CLI
+func WriteHTML(w io.Writer, s *Summary) error {
+	return htmlReport.Execute(w, s)
+}
+
+func formatSeconds(durationMs int64) string {
+	return fmt.Sprintf("%.3f s", float64(durationMs)/1000)
+}
+
+// prettyJSON indents the parameter value when it is JSON, keeping it unchanged otherwise
+func prettyJSON(value string) string {
+	var buffer bytes.Buffer
+	if err := json.Indent(&buffer, []byte(value), "", "  "); err != nil {
+		return value
+	}
+	return buffer.String()
+}
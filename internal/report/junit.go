@@ -0,0 +1,186 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Cases      []junitTestCase `xml:"testcase"`
+
+	durationMs int64
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+	Skipped   *junitProblem `xml:"skipped,omitempty"`
+}
+
+type junitProblem struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+// WriteJUnit renders the summary as JUnit XML, with a testsuite per parent suite
+// and a testcase per scenario, whose failing steps are flattened into the failure
+func WriteJUnit(w io.Writer, s *Summary) error {
+	report := junitTestSuites{Name: "SECA Conformance"}
+
+	var properties []junitProperty
+	for _, property := range []junitProperty{
+		{Name: constants.RunIDReportLabel, Value: s.RunID},
+		{Name: constants.ProfileReportLabel, Value: s.Profile},
+		{Name: constants.LevelReportLabel, Value: s.Level},
+	} {
+		if property.Value != "" {
+			properties = append(properties, property)
+		}
+	}
+
+	// Scenario names start with the parent suite, e.g. Compute.V1.ProviderLifeCycle
+	indexes := map[string]int{}
+	var totalMs int64
+	for _, sc := range s.Scenarios {
+		parentSuite, _, _ := strings.Cut(sc.Name, ".")
+		index, found := indexes[parentSuite]
+		if !found {
+			index = len(report.Suites)
+			indexes[parentSuite] = index
+			report.Suites = append(report.Suites, junitTestSuite{Name: parentSuite, Properties: properties})
+		}
+		suite := &report.Suites[index]
+
+		testCase := junitTestCase{
+			Name:      sc.Name,
+			ClassName: sc.FullName,
+			Time:      junitSeconds(sc.DurationMs),
+		}
+		switch sc.Status {
+		case statusFailed:
+			testCase.Failure = junitScenarioProblem(sc)
+			suite.Failures++
+		case statusBroken:
+			testCase.Error = junitScenarioProblem(sc)
+			suite.Errors++
+		case statusSkipped:
+			testCase.Skipped = &junitProblem{}
+			suite.Skipped++
+		}
+
+		suite.Cases = append(suite.Cases, testCase)
+		suite.Tests++
+		suite.durationMs += sc.DurationMs
+		totalMs += sc.DurationMs
+	}
+
+	for i := range report.Suites {
+		suite := &report.Suites[i]
+		suite.Time = junitSeconds(suite.durationMs)
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Errors += suite.Errors
+		report.Skipped += suite.Skipped
+	}
+	report.Time = junitSeconds(totalMs)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+func junitSeconds(durationMs int64) string {
+	return fmt.Sprintf("%.3f", float64(durationMs)/1000)
+}
+
+// junitScenarioProblem describes the scenario error, followed by the path of every failing step
+func junitScenarioProblem(sc ScenarioResult) *junitProblem {
+	problem := &junitProblem{Type: sc.Status}
+
+	var lines []string
+	for _, step := range sc.Steps {
+		lines = appendFailingSteps(lines, nil, step)
+	}
+
+	if sc.Error != nil {
+		problem.Message = sc.Error.Message
+	}
+	if problem.Message == "" && len(lines) > 0 {
+		problem.Message = lines[0]
+	}
+
+	if sc.Error != nil && sc.Error.Trace != "" {
+		lines = append(lines, "", sc.Error.Trace)
+	}
+	problem.Body = strings.Join(lines, "\n")
+	return problem
+}
+
+// appendFailingSteps adds a line for every failing step without failing children,
+// as the path of step names from the scenario down to it, followed by its error
+func appendFailingSteps(lines []string, path []string, step StepResult) []string {
+	if step.Status != statusFailed && step.Status != statusBroken {
+		return lines
+	}
+	path = append(path, step.Name)
+
+	// The diff of a verify step lists its mismatching fields, instead of the assertions failing under it
+	if len(step.Diff) > 0 {
+		for _, mismatch := range step.Diff {
+			lines = append(lines, strings.Join(path, " > ")+": "+mismatch.String())
+		}
+		return lines
+	}
+
+	before := len(lines)
+	for _, child := range step.Steps {
+		lines = appendFailingSteps(lines, path, child)
+	}
+	if len(lines) > before {
+		return lines
+	}
+
+	line := strings.Join(path, " > ")
+	if step.Error != nil && step.Error.Message != "" {
+		line += ": " + step.Error.Message
+	}
+	return append(lines, line)
+}
@@ -0,0 +1,135 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParametersMode sets how the step parameters and attachments appear in the summary
+type ParametersMode string
+
+const (
+	IncludeParameters ParametersMode = "include"
+	RedactParameters  ParametersMode = "redact"
+	OmitParameters    ParametersMode = "omit"
+)
+
+const redactedValue = "<redacted>"
+
+// redactedKeys are matched against the parameter names and the keys of their JSON values
+var redactedKeys = []string{"tenant", "token", "password", "secret", "credential", "authorization"}
+
+func ParseParametersMode(name string) (ParametersMode, error) {
+	switch mode := ParametersMode(strings.ToLower(name)); mode {
+	case IncludeParameters, RedactParameters, OmitParameters:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown parameters mode %q, must be one of include, redact, omit", name)
+	}
+}
+
+// ApplyParametersMode keeps the step parameters and attachments, masks their sensitive values or drops them
+func (s *Summary) ApplyParametersMode(mode ParametersMode) {
+	if mode == IncludeParameters || mode == "" {
+		return
+	}
+	for i := range s.Scenarios {
+		applyStepsParametersMode(s.Scenarios[i].Steps, mode)
+	}
+}
+
+func applyStepsParametersMode(steps []StepResult, mode ParametersMode) {
+	for i := range steps {
+		step := &steps[i]
+		if mode == OmitParameters {
+			step.Parameters = nil
+			step.Attachments = nil
+			step.Diff = nil
+		}
+		for j := range step.Parameters {
+			step.Parameters[j].Value = redactParameter(step.Parameters[j].Name, step.Parameters[j].Value)
+		}
+		for j := range step.Diff {
+			step.Diff[j] = redactMismatch(step.Diff[j])
+		}
+		applyStepsParametersMode(step.Steps, mode)
+	}
+}
+
+func isRedactedKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, redacted := range redactedKeys {
+		if strings.Contains(key, redacted) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactParameter masks the whole value of a sensitive parameter, or the sensitive fields of a JSON value
+func redactParameter(name string, value string) string {
+	if isRedactedKey(name) {
+		return redactedValue
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return value
+	}
+	if !redactFields(document) {
+		return value
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(document); err != nil {
+		return redactedValue
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// redactMismatch masks both values of a sensitive field, or the sensitive fields of its JSON values
+func redactMismatch(mismatch Mismatch) Mismatch {
+	if isRedactedKey(mismatch.Path) {
+		redacted := json.RawMessage(strconv.Quote(redactedValue))
+		mismatch.Expected, mismatch.Actual = redacted, redacted
+		return mismatch
+	}
+	mismatch.Expected = redactValue(mismatch.Expected)
+	mismatch.Actual = redactValue(mismatch.Actual)
+	return mismatch
+}
+
+func redactValue(value json.RawMessage) json.RawMessage {
+	if len(value) == 0 {
+		return value
+	}
+	return json.RawMessage(redactParameter("", string(value)))
+}
+
+// redactFields masks the values of the sensitive keys, reporting whether any was found
+func redactFields(document any) bool {
+	redacted := false
+	switch typed := document.(type) {
+	case map[string]any:
+		for key, value := range typed {
+			if isRedactedKey(key) {
+				typed[key] = redactedValue
+				redacted = true
+				continue
+			}
+			redacted = redactFields(value) || redacted
+		}
+	case []any:
+		for _, item := range typed {
+			redacted = redactFields(item) || redacted
+		}
+	}
+	return redacted
+}
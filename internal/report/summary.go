@@ -2,17 +2,27 @@ package report
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+	"github.com/eu-sovereign-cloud/conformance/internal/slo"
 )
 
 type Summary struct {
-	GeneratedAt time.Time        `json:"generated_at"`
-	Totals      Totals           `json:"totals"`
-	Scenarios   []ScenarioResult `json:"scenarios"`
+	GeneratedAt time.Time          `json:"generated_at"`
+	RunID       string             `json:"run_id,omitempty"`
+	Profile     string             `json:"profile,omitempty"`
+	Level       string             `json:"level,omitempty"`
+	Thresholds  []string           `json:"thresholds,omitempty"`
+	Totals      Totals             `json:"totals"`
+	Verdicts    []Verdict          `json:"verdicts"`
+	Operations  []OperationTimings `json:"operations,omitempty"`
+	Scenarios   []ScenarioResult   `json:"scenarios"`
 }
 
 type Totals struct {
@@ -27,17 +37,46 @@ type ScenarioResult struct {
 	Name       string       `json:"name"`
 	FullName   string       `json:"full_name"`
 	Status     string       `json:"status"`
+	StartedAt  time.Time    `json:"started_at"`
 	DurationMs int64        `json:"duration_ms"`
 	Error      *ErrorDetail `json:"error,omitempty"`
 	Steps      []StepResult `json:"steps,omitempty"`
 }
 
 type StepResult struct {
-	Name       string       `json:"name"`
-	Status     string       `json:"status"`
-	DurationMs int64        `json:"duration_ms"`
-	Error      *ErrorDetail `json:"error,omitempty"`
-	Steps      []StepResult `json:"steps,omitempty"`
+	Name        string       `json:"name"`
+	Status      string       `json:"status"`
+	StartedAt   time.Time    `json:"started_at"`
+	DurationMs  int64        `json:"duration_ms"`
+	Error       *ErrorDetail `json:"error,omitempty"`
+	Parameters  []Parameter  `json:"parameters,omitempty"`
+	Attachments []Attachment `json:"attachments,omitempty"`
+	Diff        []Mismatch   `json:"diff,omitempty"`
+	Steps       []StepResult `json:"steps,omitempty"`
+}
+
+// Parameter is a value recorded by a step, e.g. the JSON of a request or a response resource
+type Parameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Attachment is a file recorded by a step, stored next to the Allure results as its source
+type Attachment struct {
+	Name   string `json:"name"`
+	Type   string `json:"type,omitempty"`
+	Source string `json:"source"`
+}
+
+// Mismatch is a field of a verified resource differing from its expected value, recorded by the verify steps
+type Mismatch struct {
+	Path     string          `json:"path"`
+	Expected json.RawMessage `json:"expected"`
+	Actual   json.RawMessage `json:"actual"`
+}
+
+func (mismatch Mismatch) String() string {
+	return fmt.Sprintf("%s: expected %s, actual %s", mismatch.Path, mismatch.Expected, mismatch.Actual)
 }
 
 type ErrorDetail struct {
@@ -45,6 +84,9 @@ type ErrorDetail struct {
 	Trace   string `json:"trace,omitempty"`
 }
 
+// diffParameter is the step parameter holding the mismatches of a verify step
+const diffParameter = "diff"
+
 const (
 	statusFailed  = "failed"
 	statusBroken  = "broken"
@@ -71,6 +113,7 @@ func convertStep(s allureStep) StepResult {
 	sr := StepResult{
 		Name:       s.Name,
 		Status:     s.Status,
+		StartedAt:  time.UnixMilli(s.Start).UTC(),
 		DurationMs: s.Stop - s.Start,
 	}
 	if (s.Status == statusFailed || s.Status == statusBroken) &&
@@ -80,12 +123,45 @@ func convertStep(s allureStep) StepResult {
 			Trace:   s.StatusDetails.Trace,
 		}
 	}
+	for _, parameter := range s.Parameters {
+		sr.Parameters = append(sr.Parameters, Parameter{Name: parameter.Name, Value: parameter.Value})
+		// A malformed diff is still shown as a parameter
+		if parameter.Name == diffParameter && json.Unmarshal([]byte(parameter.Value), &sr.Diff) != nil {
+			sr.Diff = nil
+		}
+	}
+	for _, attachment := range s.Attachments {
+		sr.Attachments = append(sr.Attachments, Attachment{Name: attachment.Name, Type: attachment.Type, Source: attachment.Source})
+	}
 	for _, child := range s.Steps {
 		sr.Steps = append(sr.Steps, convertStep(child))
 	}
 	return sr
 }
 
+func labelValue(labels []allureLabel, name string) string {
+	for _, label := range labels {
+		if label.Name == name {
+			return label.Value
+		}
+	}
+	return ""
+}
+
+// labelThresholds reads the thresholds of the run recorded on the scenario, skipping the malformed ones
+func labelThresholds(labels []allureLabel) []slo.Threshold {
+	var thresholds []slo.Threshold
+	for _, label := range labels {
+		if label.Name != constants.SloReportLabel {
+			continue
+		}
+		if threshold, err := slo.ParseThreshold(label.Value); err == nil {
+			thresholds = append(thresholds, threshold)
+		}
+	}
+	return thresholds
+}
+
 func BuildSummary(resultsPath string) (*Summary, error) {
 	entries, err := os.ReadDir(resultsPath)
 	if err != nil {
@@ -93,6 +169,8 @@ func BuildSummary(resultsPath string) (*Summary, error) {
 	}
 
 	var scenarios []ScenarioResult
+	var runID, profile, level string
+	var thresholds []slo.Threshold
 	totals := Totals{}
 
 	for _, entry := range entries {
@@ -110,6 +188,19 @@ func BuildSummary(resultsPath string) (*Summary, error) {
 			return nil, err
 		}
 
+		if runID == "" {
+			runID = labelValue(ar.Labels, constants.RunIDReportLabel)
+		}
+		if profile == "" {
+			profile = labelValue(ar.Labels, constants.ProfileReportLabel)
+		}
+		if level == "" {
+			level = labelValue(ar.Labels, constants.LevelReportLabel)
+		}
+		if thresholds == nil {
+			thresholds = labelThresholds(ar.Labels)
+		}
+
 		totals.Total++
 		switch ar.Status {
 		case statusPassed:
@@ -126,6 +217,7 @@ func BuildSummary(resultsPath string) (*Summary, error) {
 			Name:       ar.Name,
 			FullName:   ar.FullName,
 			Status:     ar.Status,
+			StartedAt:  time.UnixMilli(ar.Start).UTC(),
 			DurationMs: ar.Stop - ar.Start,
 		}
 		if (ar.Status == statusFailed || ar.Status == statusBroken) &&
@@ -150,9 +242,18 @@ func BuildSummary(resultsPath string) (*Summary, error) {
 		return scenarios[i].Name < scenarios[j].Name
 	})
 
-	return &Summary{
+	summary := &Summary{
 		GeneratedAt: time.Now().UTC(),
+		RunID:       runID,
+		Profile:     profile,
+		Level:       level,
 		Totals:      totals,
+		Verdicts:    buildVerdicts(level, scenarios),
+		Operations:  buildOperationTimings(thresholds, scenarios),
 		Scenarios:   scenarios,
-	}, nil
+	}
+	for _, threshold := range thresholds {
+		summary.Thresholds = append(summary.Thresholds, threshold.String())
+	}
+	return summary, nil
 }
@@ -10,11 +10,46 @@ func WriteText(w io.Writer, s *Summary) error {
 	if _, err := fmt.Fprintf(w, "Conformance Summary — %s\n", s.GeneratedAt.Format("2006-01-02T15:04:05Z")); err != nil {
 		return err
 	}
+	if s.RunID != "" {
+		if _, err := fmt.Fprintf(w, "Run: %s\n", s.RunID); err != nil {
+			return err
+		}
+	}
+	if s.Profile != "" {
+		if _, err := fmt.Fprintf(w, "Profile: %s\n", s.Profile); err != nil {
+			return err
+		}
+	}
+	if s.Level != "" {
+		if _, err := fmt.Fprintf(w, "Level: %s\n", s.Level); err != nil {
+			return err
+		}
+	}
 	if _, err := fmt.Fprintf(w, "Total: %d  Passed: %d  Failed: %d  Broken: %d  Skipped: %d\n\n",
 		s.Totals.Total, s.Totals.Passed, s.Totals.Failed, s.Totals.Broken, s.Totals.Skipped); err != nil {
 		return err
 	}
 
+	for _, verdict := range s.Verdicts {
+		if err := writeVerdict(w, verdict); err != nil {
+			return err
+		}
+	}
+	if len(s.Verdicts) > 0 {
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+
+	if len(s.Operations) > 0 {
+		if err := writeOperationTimings(w, s.Operations); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+
 	for _, sc := range s.Scenarios {
 		if _, err := fmt.Fprintf(w, "%-8s %s  (%d ms)\n", strings.ToUpper(sc.Status), sc.FullName, sc.DurationMs); err != nil {
 			return err
@@ -44,6 +79,29 @@ func WriteText(w io.Writer, s *Summary) error {
 	return nil
 }
 
+func writeVerdict(w io.Writer, verdict Verdict) error {
+	outcome := "CONFORMANT"
+	if !verdict.Conformant {
+		outcome = "NOT CONFORMANT"
+	}
+	if _, err := fmt.Fprintf(w, "%s at level %s\n", outcome, verdict.Level); err != nil {
+		return err
+	}
+	if len(verdict.Failing) == 0 {
+		return nil
+	}
+
+	if _, err := fmt.Fprintln(w, "  Failing mandatory scenarios:"); err != nil {
+		return err
+	}
+	for _, failing := range verdict.Failing {
+		if _, err := fmt.Fprintf(w, "    %-8s %s\n", strings.ToUpper(failing.Status), failing.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func writeStep(w io.Writer, step StepResult, depth int) error {
 	indent := strings.Repeat("  ", depth)
 	if _, err := fmt.Fprintf(w, "%s%-8s %s  (%d ms)\n", indent, strings.ToUpper(step.Status), step.Name, step.DurationMs); err != nil {
@@ -54,6 +112,11 @@ func writeStep(w io.Writer, step StepResult, depth int) error {
 			return err
 		}
 	}
+	for _, mismatch := range step.Diff {
+		if _, err := fmt.Fprintf(w, "%s  Diff: %s\n", indent, mismatch); err != nil {
+			return err
+		}
+	}
 	for _, child := range step.Steps {
 		if err := writeStep(w, child, depth+1); err != nil {
 			return err
@@ -0,0 +1,147 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+	"github.com/eu-sovereign-cloud/conformance/internal/slo"
+)
+
+// Step parameters holding the times measured by the suites, in milliseconds
+const (
+	latencyParameter     = "latency_ms"
+	convergenceParameter = "convergence_ms"
+)
+
+// OperationTimings are the times measured for the calls of an operation over the run, with the thresholds they exceeded
+type OperationTimings struct {
+	Operation   string              `json:"operation"`
+	Latency     *TimingStats        `json:"latency,omitempty"`
+	Convergence *TimingStats        `json:"convergence,omitempty"`
+	Exceeded    []ExceededThreshold `json:"exceeded,omitempty"`
+}
+
+type TimingStats struct {
+	Count int   `json:"count"`
+	P50Ms int64 `json:"p50_ms"`
+	P95Ms int64 `json:"p95_ms"`
+	MaxMs int64 `json:"max_ms"`
+}
+
+// ExceededThreshold is a threshold of the run with the measure exceeding it: the highest one, or its percentile
+type ExceededThreshold struct {
+	Threshold string `json:"threshold"`
+	MeasureMs int64  `json:"measure_ms"`
+}
+
+// buildOperationTimings collects the measures of the steps by operation, and evaluates the thresholds over them
+func buildOperationTimings(thresholds []slo.Threshold, scenarios []ScenarioResult) []OperationTimings {
+	measures := map[string]map[slo.Metric][]time.Duration{}
+	for _, sc := range scenarios {
+		collectMeasures(measures, sc.Steps)
+	}
+
+	var timings []OperationTimings
+	for _, operation := range slices.Sorted(maps.Keys(measures)) {
+		operationTimings := OperationTimings{
+			Operation:   operation,
+			Latency:     timingStats(measures[operation][slo.LatencyMetric]),
+			Convergence: timingStats(measures[operation][slo.ConvergenceMetric]),
+		}
+		for _, threshold := range thresholds {
+			values := measures[operation][threshold.Metric]
+			if threshold.Operation != constants.OperationName(operation) || len(values) == 0 {
+				continue
+			}
+			if measure := threshold.Measure(values); measure > threshold.Limit {
+				operationTimings.Exceeded = append(operationTimings.Exceeded, ExceededThreshold{Threshold: threshold.String(), MeasureMs: measure.Milliseconds()})
+			}
+		}
+		timings = append(timings, operationTimings)
+	}
+	return timings
+}
+
+func collectMeasures(measures map[string]map[slo.Metric][]time.Duration, steps []StepResult) {
+	for _, step := range steps {
+		if operation := parameterValue(step.Parameters, operationParameter); operation != "" {
+			for metric, name := range map[slo.Metric]string{slo.LatencyMetric: latencyParameter, slo.ConvergenceMetric: convergenceParameter} {
+				value, err := strconv.ParseInt(parameterValue(step.Parameters, name), 10, 64)
+				if err != nil {
+					continue
+				}
+				if measures[operation] == nil {
+					measures[operation] = map[slo.Metric][]time.Duration{}
+				}
+				measures[operation][metric] = append(measures[operation][metric], time.Duration(value)*time.Millisecond)
+			}
+		}
+		collectMeasures(measures, step.Steps)
+	}
+}
+
+func timingStats(values []time.Duration) *TimingStats {
+	if len(values) == 0 {
+		return nil
+	}
+	return &TimingStats{
+		Count: len(values),
+		P50Ms: slo.Percentile(values, 50).Milliseconds(),
+		P95Ms: slo.Percentile(values, 95).Milliseconds(),
+		MaxMs: slo.Percentile(values, 100).Milliseconds(),
+	}
+}
+
+// ExceededThresholds counts the thresholds exceeded over the run
+func (s *Summary) ExceededThresholds() int {
+	count := 0
+	for _, operation := range s.Operations {
+		count += len(operation.Exceeded)
+	}
+	return count
+}
+
+func writeOperationTimings(w io.Writer, timings []OperationTimings) error {
+	if _, err := fmt.Fprintln(w, "Operation Timings (ms):"); err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(writer, "  OPERATION\tCALLS\tLATENCY P50\tP95\tMAX\tCONVERGENCE P50\tP95\tMAX\t"); err != nil {
+		return err
+	}
+	for _, timing := range timings {
+		calls := 0
+		if timing.Latency != nil {
+			calls = timing.Latency.Count
+		}
+		if _, err := fmt.Fprintf(writer, "  %s\t%d\t%s\t%s\t\n", timing.Operation, calls, formatStats(timing.Latency), formatStats(timing.Convergence)); err != nil {
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	for _, timing := range timings {
+		for _, exceeded := range timing.Exceeded {
+			if _, err := fmt.Fprintf(w, "  EXCEEDED %s (%d ms)\n", exceeded.Threshold, exceeded.MeasureMs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func formatStats(stats *TimingStats) string {
+	if stats == nil {
+		return "-\t-\t-"
+	}
+	return fmt.Sprintf("%d\t%d\t%d", stats.P50Ms, stats.P95Ms, stats.MaxMs)
+}
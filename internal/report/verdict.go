@@ -0,0 +1,57 @@
+package report
+
+import (
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+)
+
+// Verdict tells whether every scenario mandatory at a conformance level passed
+type Verdict struct {
+	Level      string            `json:"level"`
+	Conformant bool              `json:"conformant"`
+	Failing    []FailingScenario `json:"failing,omitempty"`
+}
+
+// FailingScenario is a mandatory scenario that did not pass, or is missing from the results
+type FailingScenario struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+const statusMissing = "missing"
+
+// buildVerdicts evaluates the run level and the levels below it, or every level when the run had none
+func buildVerdicts(level string, scenarios []ScenarioResult) []Verdict {
+	statuses := map[string]string{}
+	for _, sc := range scenarios {
+		// Keep the worst status of a scenario reported more than once
+		if current, found := statuses[sc.Name]; !found || statusPriority(sc.Status) < statusPriority(current) {
+			statuses[sc.Name] = sc.Status
+		}
+	}
+
+	runLevel, err := constants.ParseConformanceLevel(level)
+	if err != nil {
+		runLevel = constants.FullLevel
+	}
+
+	var verdicts []Verdict
+	for _, current := range constants.ConformanceLevels {
+		if !runLevel.Includes(current) {
+			break
+		}
+
+		verdict := Verdict{Level: current.String(), Conformant: true}
+		for _, name := range constants.MandatorySuites(current) {
+			status, found := statuses[name.String()]
+			if !found {
+				status = statusMissing
+			}
+			if status != statusPassed {
+				verdict.Conformant = false
+				verdict.Failing = append(verdict.Failing, FailingScenario{Name: name.String(), Status: status})
+			}
+		}
+		verdicts = append(verdicts, verdict)
+	}
+	return verdicts
+}
@@ -0,0 +1,132 @@
+package slo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/constants"
+)
+
+// Metric is a time measured for the calls of an operation
+type Metric string
+
+const (
+	// LatencyMetric is the response time of a call, the slowest one of the step when it sends several
+	LatencyMetric Metric = "latency"
+	// ConvergenceMetric is the time from a call changing a resource to the resource reaching its target state
+	ConvergenceMetric Metric = "convergence"
+)
+
+var metrics = []Metric{LatencyMetric, ConvergenceMetric}
+
+// Threshold limits a metric of an operation, on every measure or, with a percentile, on the measures of the run
+type Threshold struct {
+	Operation  constants.OperationName
+	Metric     Metric
+	Percentile int
+	Limit      time.Duration
+}
+
+// ParseThreshold reads a threshold written as <operation>.<metric>[.p<percentile>]=<duration>,
+// e.g. CreateOrUpdateInstance.convergence=5m or ListNetworks.latency.p95=2s
+func ParseThreshold(value string) (Threshold, error) {
+	key, limit, found := strings.Cut(strings.TrimSpace(value), "=")
+	if !found {
+		return Threshold{}, fmt.Errorf("invalid threshold %q, must be written as <operation>.<metric>[.p<percentile>]=<duration>", value)
+	}
+
+	parts := strings.Split(key, ".")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+		return Threshold{}, fmt.Errorf("invalid threshold %q, must be written as <operation>.<metric>[.p<percentile>]=<duration>", value)
+	}
+
+	threshold := Threshold{Operation: constants.OperationName(parts[0]), Metric: Metric(parts[1])}
+	if !knownOperation(threshold.Operation) {
+		return Threshold{}, fmt.Errorf("invalid threshold %q, unknown operation %q, must be an operation of the providers, e.g. ListNetworks", value, parts[0])
+	}
+	if !slices.Contains(metrics, threshold.Metric) {
+		return Threshold{}, fmt.Errorf("invalid threshold %q, unknown metric %q, must be one of latency, convergence", value, parts[1])
+	}
+
+	if len(parts) == 3 {
+		percentile, err := strconv.Atoi(strings.TrimPrefix(parts[2], "p"))
+		if err != nil || !strings.HasPrefix(parts[2], "p") || percentile < 1 || percentile > 100 {
+			return Threshold{}, fmt.Errorf("invalid threshold %q, percentile %q must be written as p1 to p100", value, parts[2])
+		}
+		threshold.Percentile = percentile
+	}
+
+	duration, err := time.ParseDuration(limit)
+	if err != nil || duration <= 0 {
+		return Threshold{}, fmt.Errorf("invalid threshold %q, limit %q must be a positive duration, e.g. 2s or 5m", value, limit)
+	}
+	threshold.Limit = duration
+	return threshold, nil
+}
+
+// knownOperation reports whether a provider has the operation, so a misspelled one is not silently never evaluated
+func knownOperation(operation constants.OperationName) bool {
+	for _, operations := range constants.ProviderV1Operations {
+		if slices.Contains(operations, operation) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseThresholds reads the thresholds, reporting every malformed one at once
+func ParseThresholds(values []string) ([]Threshold, error) {
+	var thresholds []Threshold
+	var errs []error
+	for _, value := range values {
+		threshold, err := ParseThreshold(value)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	return thresholds, errors.Join(errs...)
+}
+
+func (threshold Threshold) String() string {
+	key := string(threshold.Operation) + "." + string(threshold.Metric)
+	if threshold.Percentile > 0 {
+		key += ".p" + strconv.Itoa(threshold.Percentile)
+	}
+	return key + "=" + threshold.Limit.String()
+}
+
+// Measure returns the value of the measures the threshold limits: their percentile, or the highest one
+func (threshold Threshold) Measure(measures []time.Duration) time.Duration {
+	if threshold.Percentile > 0 {
+		return Percentile(measures, threshold.Percentile)
+	}
+	return Percentile(measures, 100)
+}
+
+// Find returns the threshold limiting every measure of the metric of the operation, if any
+func Find(thresholds []Threshold, operation constants.OperationName, metric Metric) (Threshold, bool) {
+	for _, threshold := range thresholds {
+		if threshold.Operation == operation && threshold.Metric == metric && threshold.Percentile == 0 {
+			return threshold, true
+		}
+	}
+	return Threshold{}, false
+}
+
+// Percentile returns the nearest-rank percentile of the measures, zero without any
+func Percentile(measures []time.Duration, percentile int) time.Duration {
+	if len(measures) == 0 {
+		return 0
+	}
+
+	sorted := slices.Sorted(slices.Values(measures))
+	rank := int(math.Ceil(float64(percentile) / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
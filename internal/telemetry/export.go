@@ -0,0 +1,89 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	tracesPath    = "/v1/traces"
+	exportTimeout = 30 * time.Second
+)
+
+// Exporter sends the traces to an OTLP/HTTP collector, writing them to a file when it cannot be reached
+type Exporter struct {
+	// Endpoint is the base URL of the collector, e.g. http://localhost:4318, or its full traces URL
+	Endpoint string
+	Headers  map[string]string
+	// File receives the traces when no endpoint is set, or when the export to it fails
+	File string
+}
+
+// Export sends the traces, reporting whether they were written to the fallback file instead
+func (exporter Exporter) Export(ctx context.Context, traces *Traces) (bool, error) {
+	data, err := json.Marshal(traces)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode traces: %w", err)
+	}
+
+	if exporter.Endpoint != "" {
+		err = exporter.send(ctx, data)
+		if err == nil || exporter.File == "" {
+			return false, err
+		}
+	}
+	if writeErr := os.WriteFile(exporter.File, data, 0o600); writeErr != nil {
+		return false, fmt.Errorf("failed to write traces file: %w", writeErr)
+	}
+	return true, err
+}
+
+func (exporter Exporter) send(ctx context.Context, data []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
+	defer cancel()
+
+	url := exporter.Endpoint
+	if !strings.HasSuffix(url, tracesPath) {
+		url = strings.TrimSuffix(url, "/") + tracesPath
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("invalid OTLP endpoint: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range exporter.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to export traces: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to export traces: collector returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// ParseHeaders reads the key=value pairs of the headers sent to the collector
+func ParseHeaders(pairs []string) (map[string]string, error) {
+	headers := map[string]string{}
+	for _, pair := range pairs {
+		name, value, found := strings.Cut(pair, "=")
+		if !found || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid header %q, must be written as name=value", pair)
+		}
+		headers[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	return headers, nil
+}
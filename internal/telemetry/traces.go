@@ -0,0 +1,157 @@
+package telemetry
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/eu-sovereign-cloud/conformance/internal/report"
+)
+
+const (
+	serviceName = "secatest"
+	scopeName   = "github.com/eu-sovereign-cloud/conformance"
+
+	spanKindInternal = 1
+	statusCodeError  = 2
+)
+
+// stepAttributes maps the step parameters set by the suites to the span attributes
+var stepAttributes = map[string]string{
+	"provider":  "seca.provider",
+	"operation": "seca.operation",
+	"tenant":    "seca.tenant",
+	"workspace": "seca.workspace",
+	"network":   "seca.network",
+}
+
+// Traces is the OTLP/JSON encoding of the run, with one trace per suite and a span per step
+type Traces struct {
+	ResourceSpans []ResourceSpans `json:"resourceSpans"`
+}
+
+type ResourceSpans struct {
+	Resource   Resource     `json:"resource"`
+	ScopeSpans []ScopeSpans `json:"scopeSpans"`
+}
+
+type Resource struct {
+	Attributes []Attribute `json:"attributes"`
+}
+
+type ScopeSpans struct {
+	Scope Scope  `json:"scope"`
+	Spans []Span `json:"spans"`
+}
+
+type Scope struct {
+	Name string `json:"name"`
+}
+
+type Span struct {
+	TraceID           string      `json:"traceId"`
+	SpanID            string      `json:"spanId"`
+	ParentSpanID      string      `json:"parentSpanId,omitempty"`
+	Name              string      `json:"name"`
+	Kind              int         `json:"kind"`
+	StartTimeUnixNano string      `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string      `json:"endTimeUnixNano"`
+	Attributes        []Attribute `json:"attributes,omitempty"`
+	Status            Status      `json:"status"`
+}
+
+type Attribute struct {
+	Key   string `json:"key"`
+	Value Value  `json:"value"`
+}
+
+type Value struct {
+	StringValue string `json:"stringValue"`
+}
+
+type Status struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// BuildTraces converts every scenario of the summary into a trace, its root span being the suite
+// and the nested spans its steps. The identifiers derive from the run ID and the scenario,
+// so exporting the same results twice gives the same traces
+func BuildTraces(s *report.Summary) *Traces {
+	resource := Resource{Attributes: []Attribute{attribute("service.name", serviceName)}}
+	for _, attr := range []Attribute{
+		attribute("conformance.run_id", s.RunID),
+		attribute("conformance.profile", s.Profile),
+		attribute("conformance.level", s.Level),
+	} {
+		if attr.Value.StringValue != "" {
+			resource.Attributes = append(resource.Attributes, attr)
+		}
+	}
+
+	var spans []Span
+	for _, scenario := range s.Scenarios {
+		traceID := identifier(16, s.RunID, scenario.Name, scenario.StartedAt.String())
+		rootID := identifier(8, traceID)
+		root := newSpan(traceID, rootID, "", scenario.Name, scenario.StartedAt, scenario.DurationMs, scenario.Status, scenario.Error)
+		root.Attributes = append(root.Attributes, attribute("conformance.scenario", scenario.Name))
+		spans = append(spans, root)
+		spans = appendStepSpans(spans, traceID, rootID, scenario.Steps)
+	}
+
+	return &Traces{ResourceSpans: []ResourceSpans{{
+		Resource:   resource,
+		ScopeSpans: []ScopeSpans{{Scope: Scope{Name: scopeName}, Spans: spans}},
+	}}}
+}
+
+func appendStepSpans(spans []Span, traceID string, parentID string, steps []report.StepResult) []Span {
+	for i, step := range steps {
+		spanID := identifier(8, parentID, strconv.Itoa(i))
+		span := newSpan(traceID, spanID, parentID, step.Name, step.StartedAt, step.DurationMs, step.Status, step.Error)
+		for _, parameter := range step.Parameters {
+			if key, found := stepAttributes[parameter.Name]; found {
+				span.Attributes = append(span.Attributes, attribute(key, parameter.Value))
+			}
+		}
+		spans = append(spans, span)
+		spans = appendStepSpans(spans, traceID, spanID, step.Steps)
+	}
+	return spans
+}
+
+func newSpan(traceID string, spanID string, parentID string, name string, start time.Time, durationMs int64, status string, detail *report.ErrorDetail) Span {
+	span := Span{
+		TraceID:           traceID,
+		SpanID:            spanID,
+		ParentSpanID:      parentID,
+		Name:              name,
+		Kind:              spanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(start.Add(time.Duration(durationMs)*time.Millisecond).UnixNano(), 10),
+		Attributes:        []Attribute{attribute("conformance.status", status)},
+	}
+	// Passed steps keep the unset status, as recommended for instrumentations
+	if status == "failed" || status == "broken" {
+		span.Status.Code = statusCodeError
+		if detail != nil {
+			span.Status.Message = detail.Message
+		}
+	}
+	return span
+}
+
+func attribute(key string, value string) Attribute {
+	return Attribute{Key: key, Value: Value{StringValue: value}}
+}
+
+// identifier hashes the parts into a hex encoded trace or span ID of the given size in bytes
+func identifier(size int, parts ...string) string {
+	hash := sha256.New()
+	for _, part := range parts {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil)[:size])
+}
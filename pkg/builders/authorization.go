@@ -103,9 +103,9 @@ func (builder *RoleBuilder) Build() (*schema.Role, error) {
 
 	return &schema.Role{
 		Metadata:    metadata,
-		Annotations: builder.annotations,
+		Annotations: withCommonAnnotations(builder.annotations),
 		Extensions:  builder.extensions,
-		Labels:      builder.labels,
+		Labels:      withCommonLabels(builder.labels),
 		Spec:        *builder.spec,
 		Status:      &schema.RoleStatus{},
 	}, nil
@@ -240,8 +240,8 @@ func (builder *RoleAssignmentBuilder) Build() (*schema.RoleAssignment, error) {
 
 	return &schema.RoleAssignment{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotations,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotations),
 		Extensions:  builder.extensions,
 		Spec:        *builder.spec,
 		Status:      &schema.RoleAssignmentStatus{},
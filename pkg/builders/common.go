@@ -0,0 +1,56 @@
+package builders
+
+import (
+	"maps"
+	"sync"
+
+	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+)
+
+// Common labels and annotations stamped on every built resource
+
+var (
+	commonLabels      schema.Labels
+	commonAnnotations schema.Annotations
+	commonLock        sync.RWMutex
+)
+
+func SetCommonLabels(labels schema.Labels) {
+	commonLock.Lock()
+	defer commonLock.Unlock()
+
+	commonLabels = maps.Clone(labels)
+}
+
+func SetCommonAnnotations(annotations schema.Annotations) {
+	commonLock.Lock()
+	defer commonLock.Unlock()
+
+	commonAnnotations = maps.Clone(annotations)
+}
+
+func withCommonLabels(labels schema.Labels) schema.Labels {
+	commonLock.RLock()
+	defer commonLock.RUnlock()
+
+	return mergeCommon(labels, commonLabels)
+}
+
+func withCommonAnnotations(annotations schema.Annotations) schema.Annotations {
+	commonLock.RLock()
+	defer commonLock.RUnlock()
+
+	return mergeCommon(annotations, commonAnnotations)
+}
+
+// mergeCommon returns a copy of values with the common entries added, keeping the values set explicitly
+func mergeCommon[M ~map[string]string](values M, common M) M {
+	if len(common) == 0 {
+		return values
+	}
+
+	merged := make(M, len(values)+len(common))
+	maps.Copy(merged, common)
+	maps.Copy(merged, values)
+	return merged
+}
@@ -151,8 +151,8 @@ func (builder *InstanceBuilder) Build() (*schema.Instance, error) {
 
 	return &schema.Instance{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotatons,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotatons),
 		Extensions:  builder.extensions,
 		Spec:        *builder.spec,
 		Status:      &schema.InstanceStatus{},
@@ -135,8 +135,8 @@ func (builder *NetworkBuilder) Build() (*schema.Network, error) {
 
 	return &schema.Network{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotations,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotations),
 		Extensions:  builder.extensions,
 		Spec:        *builder.spec,
 		Status:      &schema.NetworkStatus{},
@@ -281,8 +281,8 @@ func (builder *InternetGatewayBuilder) Build() (*schema.InternetGateway, error)
 
 	return &schema.InternetGateway{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotations,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotations),
 		Extensions:  builder.extensions,
 		Spec:        *builder.spec,
 		Status:      &schema.InternetGatewayStatus{},
@@ -417,8 +417,8 @@ func (builder *RouteTableBuilder) Build() (*schema.RouteTable, error) {
 
 	return &schema.RouteTable{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotations,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotations),
 		Extensions:  builder.extensions,
 		Spec:        *builder.spec,
 		Status:      &schema.RouteTableStatus{},
@@ -546,8 +546,8 @@ func (builder *SubnetBuilder) Build() (*schema.Subnet, error) {
 
 	return &schema.Subnet{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotations,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotations),
 		Extensions:  builder.extensions,
 		Spec:        *builder.spec,
 		Status:      &schema.SubnetStatus{},
@@ -672,8 +672,8 @@ func (builder *PublicIpBuilder) Build() (*schema.PublicIp, error) {
 
 	return &schema.PublicIp{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotations,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotations),
 		Extensions:  builder.extensions,
 		Spec:        *builder.spec,
 		Status:      &schema.PublicIpStatus{},
@@ -799,8 +799,8 @@ func (builder *NicBuilder) Build() (*schema.Nic, error) {
 
 	return &schema.Nic{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotations,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotations),
 		Extensions:  builder.extensions,
 		Spec:        *builder.spec,
 		Status:      &schema.NicStatus{},
@@ -925,8 +925,8 @@ func (builder *SecurityGroupRuleBuilder) Build() (*schema.SecurityGroupRule, err
 
 	return &schema.SecurityGroupRule{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotations,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotations),
 		Extensions:  builder.extensions,
 		Spec:        *builder.spec,
 		Status:      &schema.Status{},
@@ -1024,8 +1024,8 @@ func (builder *SecurityGroupBuilder) Build() (*schema.SecurityGroup, error) {
 
 	return &schema.SecurityGroup{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotations,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotations),
 		Extensions:  builder.extensions,
 		Spec:        *builder.spec,
 		Status:      &schema.SecurityGroupStatus{},
@@ -128,8 +128,8 @@ func (builder *BlockStorageBuilder) Build() (*schema.BlockStorage, error) {
 
 	return &schema.BlockStorage{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotatons,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotatons),
 		Extensions:  builder.extensions,
 		Spec:        *builder.spec,
 		Status:      &schema.BlockStorageStatus{},
@@ -254,8 +254,8 @@ func (builder *ImageBuilder) Build() (*schema.Image, error) {
 
 	return &schema.Image{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotations,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotations),
 		Extensions:  builder.extensions,
 		Spec:        *builder.spec,
 		Status:      &schema.ImageStatus{},
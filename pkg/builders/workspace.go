@@ -72,8 +72,8 @@ func (builder *WorkspaceBuilder) Build() (*schema.Workspace, error) {
 
 	return &schema.Workspace{
 		Metadata:    metadata,
-		Labels:      builder.labels,
-		Annotations: builder.annotations,
+		Labels:      withCommonLabels(builder.labels),
+		Annotations: withCommonAnnotations(builder.annotations),
 		Extensions:  builder.extensions,
 		Spec:        schema.WorkspaceSpec{},
 		Status:      &schema.WorkspaceStatus{},
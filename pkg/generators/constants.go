@@ -16,6 +16,7 @@ const (
 	nicName               = "nic-%d"
 	securityGroupRuleName = "security-group-rule-%d"
 	securityGroupName     = "security-group-%d"
+	runID                 = "run-%d"
 
 	// Endpoint URLs
 	urlProvidersPrefix  = "/providers/%s"
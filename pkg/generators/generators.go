@@ -1,7 +1,9 @@
 package generators
 
 import (
+	"fmt"
 	"net"
+	"sync"
 
 	"github.com/apparentlymart/go-cidr/cidr"
 )
@@ -49,3 +51,54 @@ func GeneratePublicIp(publicIpRange string, hostNum int) (string, error) {
 
 	return ip.String(), nil
 }
+
+// Allocation
+
+type addressAllocator struct {
+	lock sync.Mutex
+	next map[string]uint64
+}
+
+var (
+	subnetAllocator   = &addressAllocator{next: map[string]uint64{}}
+	publicIpAllocator = &addressAllocator{next: map[string]uint64{}}
+)
+
+// allocate returns the next number of the range, skipping the first one and wrapping once all were handed out
+func (allocator *addressAllocator) allocate(key string, capacity uint64) int {
+	allocator.lock.Lock()
+	defer allocator.lock.Unlock()
+
+	capacity = max(capacity, 1)
+	num := allocator.next[key]
+	allocator.next[key] = (num + 1) % capacity
+	return int(num) + 1
+}
+
+// AllocateSubnetCidr returns a subnet not handed out to any other suite of the run, while the network has room for it
+func AllocateSubnetCidr(networkCidr string, size int) (string, error) {
+	_, network, err := net.ParseCIDR(networkCidr)
+	if err != nil {
+		return "", err
+	}
+
+	capacity := uint64(1)<<size - 1
+	netNum := subnetAllocator.allocate(fmt.Sprintf("%s+%d", network, size), capacity)
+	return GenerateSubnetCidr(networkCidr, size, netNum)
+}
+
+// AllocatePublicIp returns an address not handed out to any other suite of the run, while the range has room for it
+func AllocatePublicIp(publicIpRange string) (string, error) {
+	_, network, err := net.ParseCIDR(publicIpRange)
+	if err != nil {
+		return "", err
+	}
+
+	// Leave out the network and broadcast addresses
+	capacity := uint64(1)
+	if ones, bits := network.Mask.Size(); bits-ones > 1 {
+		capacity = uint64(1)<<(bits-ones) - 2
+	}
+	hostNum := publicIpAllocator.allocate(network.String(), capacity)
+	return GeneratePublicIp(publicIpRange, hostNum)
+}
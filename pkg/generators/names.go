@@ -61,3 +61,7 @@ func GenerateSecurityGroupRuleName() string {
 func GenerateSecurityGroupName() string {
 	return fmt.Sprintf(securityGroupName, rand.Intn(math.MaxInt32))
 }
+
+func GenerateRunID() string {
+	return fmt.Sprintf(runID, rand.Intn(math.MaxInt32))
+}